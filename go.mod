@@ -23,6 +23,7 @@ require (
 	github.com/stretchr/testify v1.6.1
 	github.com/tredoe/osutil v1.0.5
 	golang.org/x/net v0.0.0-20200822124328-c89045814202
+	golang.org/x/text v0.3.3
 	google.golang.org/genproto v0.0.0-20200624020401-64a14ca9d1ad
 	google.golang.org/protobuf v1.25.0
 )
@@ -1,6 +1,8 @@
 // Package config should be moved to internal
 package config
 
+import "time"
+
 // LDAP defines the available ldap configuration.
 type LDAP struct {
 	Hostname     string
@@ -44,6 +46,55 @@ type Server struct {
 	AccountsDataPath string
 }
 
+// Search defines the available search and pagination configuration.
+type Search struct {
+	MaxPageSize     int
+	PageTokenSecret string
+	StoreRecords    bool
+	MaxQueryDepth   int
+	MaxQueryClauses int
+	MaxResultWindow int
+	QueryTimeout    time.Duration
+}
+
+// Groups defines the available group configuration.
+type Groups struct {
+	MaxNestingDepth    int
+	TransitiveMemberOf bool
+}
+
+// Auth defines the available authentication configuration.
+type Auth struct {
+	LoginAttributes       string
+	PasswordHashAlgorithm string
+	PasswordHashCost      int
+
+	LockoutThreshold       int
+	SourceLockoutThreshold int
+	LockoutDuration        time.Duration
+	MaxLockoutDuration     time.Duration
+	TrustedProxies         string
+
+	MaxPasswordAge  time.Duration
+	PasswordHistory int
+}
+
+// PasswordPolicy defines the requirements for new passwords.
+type PasswordPolicy struct {
+	MinLength             int
+	MinCharacterClasses   int
+	MinEntropy            float64
+	DisallowPersonalData  bool
+	BreachedPasswordsFile string
+}
+
+// MFA defines the available multi-factor authentication configuration.
+type MFA struct {
+	Issuer        string
+	SecretKey     string
+	SecretKeyFile string
+}
+
 // Asset defines the available asset configuration.
 type Asset struct {
 	Path string
@@ -63,13 +114,18 @@ type Log struct {
 
 // Config merges all Account config parameters.
 type Config struct {
-	LDAP         LDAP
-	HTTP         HTTP
-	GRPC         GRPC
-	Server       Server
-	Asset        Asset
-	Log          Log
-	TokenManager TokenManager
+	LDAP           LDAP
+	HTTP           HTTP
+	GRPC           GRPC
+	Server         Server
+	Search         Search
+	Groups         Groups
+	Auth           Auth
+	PasswordPolicy PasswordPolicy
+	MFA            MFA
+	Asset          Asset
+	Log            Log
+	TokenManager   TokenManager
 }
 
 // New returns a new config.
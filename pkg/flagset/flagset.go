@@ -1,6 +1,8 @@
 package flagset
 
 import (
+	"time"
+
 	"github.com/micro/cli/v2"
 	"github.com/owncloud/ocis-accounts/pkg/config"
 	accounts "github.com/owncloud/ocis-accounts/pkg/proto/v0"
@@ -85,6 +87,195 @@ func ServerWithConfig(cfg *config.Config) []cli.Flag {
 			EnvVars:     []string{"ACCOUNTS_DATA_PATH"},
 			Destination: &cfg.Server.AccountsDataPath,
 		},
+		&cli.IntFlag{
+			Name:        "max-page-size",
+			Value:       1000,
+			Usage:       "Maximum number of records returned per page by list requests, it must not exceed the max result window",
+			EnvVars:     []string{"ACCOUNTS_MAX_PAGE_SIZE"},
+			Destination: &cfg.Search.MaxPageSize,
+		},
+		&cli.StringFlag{
+			Name:        "page-token-secret",
+			Value:       "",
+			Usage:       "Used to sign page tokens, a random secret is generated on startup if empty",
+			EnvVars:     []string{"ACCOUNTS_PAGE_TOKEN_SECRET"},
+			Destination: &cfg.Search.PageTokenSecret,
+		},
+		&cli.BoolFlag{
+			Value:       false,
+			Name:        "store-records",
+			Usage:       "Store the records in the index to serve list requests without reading them from disk",
+			EnvVars:     []string{"ACCOUNTS_STORE_RECORDS"},
+			Destination: &cfg.Search.StoreRecords,
+		},
+		&cli.IntFlag{
+			Name:        "max-query-depth",
+			Value:       16,
+			Usage:       "Maximum nesting depth of and, or and not in queries",
+			EnvVars:     []string{"ACCOUNTS_MAX_QUERY_DEPTH"},
+			Destination: &cfg.Search.MaxQueryDepth,
+		},
+		&cli.IntFlag{
+			Name:        "max-query-clauses",
+			Value:       1024,
+			Usage:       "Maximum number of clauses in queries",
+			EnvVars:     []string{"ACCOUNTS_MAX_QUERY_CLAUSES"},
+			Destination: &cfg.Search.MaxQueryClauses,
+		},
+		&cli.IntFlag{
+			Name:        "max-result-window",
+			Value:       10000,
+			Usage:       "Maximum number of records that can be paged through for a single query",
+			EnvVars:     []string{"ACCOUNTS_MAX_RESULT_WINDOW"},
+			Destination: &cfg.Search.MaxResultWindow,
+		},
+		&cli.DurationFlag{
+			Name:        "query-timeout",
+			Value:       10 * time.Second,
+			Usage:       "Maximum duration of a search in the index",
+			EnvVars:     []string{"ACCOUNTS_QUERY_TIMEOUT"},
+			Destination: &cfg.Search.QueryTimeout,
+		},
+		&cli.IntFlag{
+			Name:        "max-group-nesting-depth",
+			Value:       10,
+			Usage:       "Maximum number of levels groups can be nested",
+			EnvVars:     []string{"ACCOUNTS_MAX_GROUP_NESTING_DEPTH"},
+			Destination: &cfg.Groups.MaxNestingDepth,
+		},
+		&cli.BoolFlag{
+			Value:       false,
+			Name:        "transitive-member-of",
+			Usage:       "Include the groups accounts are members of through nested groups in their memberOf",
+			EnvVars:     []string{"ACCOUNTS_TRANSITIVE_MEMBER_OF"},
+			Destination: &cfg.Groups.TransitiveMemberOf,
+		},
+		&cli.StringFlag{
+			Name:        "login-attributes",
+			Value:       "on_premises_sam_account_name,mail",
+			Usage:       "Comma separated list of the account attributes that are matched against the login when authenticating",
+			EnvVars:     []string{"ACCOUNTS_LOGIN_ATTRIBUTES"},
+			Destination: &cfg.Auth.LoginAttributes,
+		},
+		&cli.StringFlag{
+			Name:        "password-hash-algorithm",
+			Value:       "bcrypt",
+			Usage:       "Algorithm used to hash passwords, one of bcrypt, argon2id or scrypt",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_HASH_ALGORITHM"},
+			Destination: &cfg.Auth.PasswordHashAlgorithm,
+		},
+		&cli.IntFlag{
+			Name:        "password-hash-cost",
+			Value:       0,
+			Usage:       "Cost of the password hash algorithm: the bcrypt cost, the argon2id iterations or the binary logarithm of the scrypt cost. 0 uses the default of the algorithm",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_HASH_COST"},
+			Destination: &cfg.Auth.PasswordHashCost,
+		},
+		&cli.IntFlag{
+			Name:        "lockout-threshold",
+			Value:       5,
+			Usage:       "Number of failed sign-in attempts after which an account is locked, 0 disables the lockout",
+			EnvVars:     []string{"ACCOUNTS_LOCKOUT_THRESHOLD"},
+			Destination: &cfg.Auth.LockoutThreshold,
+		},
+		&cli.IntFlag{
+			Name:        "source-lockout-threshold",
+			Value:       0,
+			Usage:       "Number of failed sign-in attempts after which a client address is locked, 0 disables the lockout. It needs trusted proxies",
+			EnvVars:     []string{"ACCOUNTS_SOURCE_LOCKOUT_THRESHOLD"},
+			Destination: &cfg.Auth.SourceLockoutThreshold,
+		},
+		&cli.DurationFlag{
+			Name:        "lockout-duration",
+			Value:       time.Minute,
+			Usage:       "Duration of the first lockout, it doubles with every further failed attempt",
+			EnvVars:     []string{"ACCOUNTS_LOCKOUT_DURATION"},
+			Destination: &cfg.Auth.LockoutDuration,
+		},
+		&cli.DurationFlag{
+			Name:        "max-lockout-duration",
+			Value:       time.Hour,
+			Usage:       "Maximum duration of a lockout",
+			EnvVars:     []string{"ACCOUNTS_MAX_LOCKOUT_DURATION"},
+			Destination: &cfg.Auth.MaxLockoutDuration,
+		},
+		&cli.StringFlag{
+			Name:        "trusted-proxies",
+			Value:       "",
+			Usage:       "Comma separated addresses or networks of proxies whose X-Forwarded-For and X-Real-Ip headers are used as the client address for the lockout",
+			EnvVars:     []string{"ACCOUNTS_TRUSTED_PROXIES"},
+			Destination: &cfg.Auth.TrustedProxies,
+		},
+		&cli.DurationFlag{
+			Name:        "max-password-age",
+			Value:       0,
+			Usage:       "Maximum age of passwords, older passwords have to be changed before signing in. 0 disables the expiration",
+			EnvVars:     []string{"ACCOUNTS_MAX_PASSWORD_AGE"},
+			Destination: &cfg.Auth.MaxPasswordAge,
+		},
+		&cli.IntFlag{
+			Name:        "password-history",
+			Value:       5,
+			Usage:       "Number of previous passwords that cannot be used again, 0 disables the check",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_HISTORY"},
+			Destination: &cfg.Auth.PasswordHistory,
+		},
+		&cli.IntFlag{
+			Name:        "password-min-length",
+			Value:       8,
+			Usage:       "Minimum number of characters of new passwords",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_MIN_LENGTH"},
+			Destination: &cfg.PasswordPolicy.MinLength,
+		},
+		&cli.IntFlag{
+			Name:        "password-min-character-classes",
+			Value:       3,
+			Usage:       "Minimum number of lower case letters, upper case letters, digits and other characters new passwords have to use",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_MIN_CHARACTER_CLASSES"},
+			Destination: &cfg.PasswordPolicy.MinCharacterClasses,
+		},
+		&cli.Float64Flag{
+			Name:        "password-min-entropy",
+			Value:       40,
+			Usage:       "Minimum estimated entropy of new passwords in bits, repeated characters and sequences are rated lower",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_MIN_ENTROPY"},
+			Destination: &cfg.PasswordPolicy.MinEntropy,
+		},
+		&cli.BoolFlag{
+			Name:        "password-disallow-personal-data",
+			Value:       true,
+			Usage:       "Reject new passwords containing the username, mail or display name",
+			EnvVars:     []string{"ACCOUNTS_PASSWORD_DISALLOW_PERSONAL_DATA"},
+			Destination: &cfg.PasswordPolicy.DisallowPersonalData,
+		},
+		&cli.StringFlag{
+			Name:        "breached-passwords-file",
+			Value:       "",
+			Usage:       "File with breached passwords or their SHA-1 hashes, one per line, that are rejected as new passwords",
+			EnvVars:     []string{"ACCOUNTS_BREACHED_PASSWORDS_FILE"},
+			Destination: &cfg.PasswordPolicy.BreachedPasswordsFile,
+		},
+		&cli.StringFlag{
+			Name:        "mfa-issuer",
+			Value:       "ownCloud",
+			Usage:       "Issuer shown in authenticator apps for TOTP secrets",
+			EnvVars:     []string{"ACCOUNTS_MFA_ISSUER"},
+			Destination: &cfg.MFA.Issuer,
+		},
+		&cli.StringFlag{
+			Name:        "mfa-secret-key",
+			Value:       "",
+			Usage:       "Used to encrypt the TOTP secrets. Without a key or key file, TOTP cannot be enrolled",
+			EnvVars:     []string{"ACCOUNTS_MFA_SECRET_KEY"},
+			Destination: &cfg.MFA.SecretKey,
+		},
+		&cli.StringFlag{
+			Name:        "mfa-secret-key-file",
+			Value:       "",
+			Usage:       "File with the key used to encrypt the TOTP secrets if no key is set, a key is generated if it does not exist. It must not be in the accounts data path",
+			EnvVars:     []string{"ACCOUNTS_MFA_SECRET_KEY_FILE"},
+			Destination: &cfg.MFA.SecretKeyFile,
+		},
 		&cli.StringFlag{
 			Name:        "asset-path",
 			Value:       "",
@@ -0,0 +1,88 @@
+package mfa
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// keySize is the size of generated keys in bytes
+const keySize = 32
+
+// Cipher encrypts the secrets of accounts at rest with AES-GCM
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher returns a cipher for the key. Keys of any length are accepted, the AES-256 key is derived with SHA-256.
+func NewCipher(key []byte) (*Cipher, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key must not be empty")
+	}
+	k := sha256.Sum256(key)
+	block, err := aes.NewCipher(k[:])
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Encrypt encrypts the plaintext. The ciphertext can only be decrypted with the same additional data, eg. the id of
+// the account, so it cannot be copied to another account.
+func (c *Cipher) Encrypt(plaintext []byte, additionalData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(c.aead.Seal(nonce, nonce, plaintext, additionalData)), nil
+}
+
+// Decrypt decrypts a ciphertext returned by Encrypt
+func (c *Cipher) Decrypt(ciphertext string, additionalData []byte) ([]byte, error) {
+	b, err := base64.StdEncoding.DecodeString(ciphertext)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) < c.aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, sealed := b[:c.aead.NonceSize()], b[c.aead.NonceSize():]
+	return c.aead.Open(nil, nonce, sealed, additionalData)
+}
+
+// LoadOrCreateKey reads the key from the file. If the file does not exist, a random key is generated and written to
+// it.
+func LoadOrCreateKey(path string) ([]byte, error) {
+	key, err := ioutil.ReadFile(path)
+	if err == nil {
+		if len(key) == 0 {
+			return nil, errors.New("key file is empty")
+		}
+		return key, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	key = make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(path, key, 0600); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
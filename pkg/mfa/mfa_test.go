@@ -0,0 +1,104 @@
+package mfa
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// the SHA-1 test vectors of RFC 6238, truncated to six digits
+func TestCode(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	for unix, code := range map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	} {
+		assert.Equal(t, code, Code(secret, Step(time.Unix(unix, 0))), unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+	now := time.Now()
+
+	step, ok := Validate(secret, Code(secret, Step(now)), now)
+	assert.True(t, ok)
+	assert.Equal(t, Step(now), step)
+
+	_, ok = Validate(secret, Code(secret, Step(now)-1), now)
+	assert.True(t, ok, "the previous code is accepted for clock drift")
+
+	_, ok = Validate(secret, Code(secret, Step(now)-2), now)
+	assert.False(t, ok)
+
+	_, ok = Validate(secret, "", now)
+	assert.False(t, ok)
+}
+
+func TestSecretEncoding(t *testing.T) {
+	secret, err := GenerateSecret()
+	assert.NoError(t, err)
+	decoded, err := DecodeSecret(strings.ToLower(EncodeSecret(secret)))
+	assert.NoError(t, err)
+	assert.Equal(t, secret, decoded)
+
+	uri := KeyURI("ownCloud", "einstein", secret)
+	assert.True(t, strings.HasPrefix(uri, "otpauth://totp/ownCloud:einstein?"), uri)
+	assert.Contains(t, uri, "secret="+EncodeSecret(secret))
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, err := GenerateRecoveryCodes(RecoveryCodes)
+	assert.NoError(t, err)
+	assert.Len(t, codes, RecoveryCodes)
+
+	hashes := make([]string, 0, len(codes))
+	for _, c := range codes {
+		assert.Len(t, c, 19)
+		hashes = append(hashes, HashRecoveryCode(c))
+	}
+	assert.Equal(t, 3, MatchRecoveryCode(hashes, codes[3]))
+	assert.Equal(t, 3, MatchRecoveryCode(hashes, strings.ToUpper(strings.ReplaceAll(codes[3], "-", ""))))
+	assert.Equal(t, -1, MatchRecoveryCode(hashes, "aaaa-aaaa-aaaa-aaaa"))
+}
+
+func TestCipher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ocis-accounts-mfa")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "mfa.key")
+	key, err := LoadOrCreateKey(path)
+	assert.NoError(t, err)
+	again, err := LoadOrCreateKey(path)
+	assert.NoError(t, err)
+	assert.Equal(t, key, again)
+
+	c, err := NewCipher(key)
+	assert.NoError(t, err)
+	ciphertext, err := c.Encrypt([]byte("secret"), []byte("einstein"))
+	assert.NoError(t, err)
+	assert.NotContains(t, ciphertext, "secret")
+
+	plaintext, err := c.Decrypt(ciphertext, []byte("einstein"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), plaintext)
+
+	_, err = c.Decrypt(ciphertext, []byte("curie"))
+	assert.Error(t, err, "secrets cannot be moved to other accounts")
+
+	other, err := NewCipher([]byte("other key"))
+	assert.NoError(t, err)
+	_, err = other.Decrypt(ciphertext, []byte("einstein"))
+	assert.Error(t, err)
+}
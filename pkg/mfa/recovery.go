@@ -0,0 +1,55 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// RecoveryCodes is the number of recovery codes generated for an account
+	RecoveryCodes = 10
+	// recoveryCodeSize is the number of random bytes of a recovery code, they are encoded as 16 characters
+	recoveryCodeSize = 10
+)
+
+// recoveryEncoding avoids the characters l, o, 0 and 1 that are easily confused
+var recoveryEncoding = base32.NewEncoding("abcdefghijkmnpqrstuvwxyz23456789").WithPadding(base32.NoPadding)
+
+// GenerateRecoveryCodes returns n random recovery codes. Every code can be used once instead of a TOTP code.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		code := recoveryEncoding.EncodeToString(b)
+		codes = append(codes, code[0:4]+"-"+code[4:8]+"-"+code[8:12]+"-"+code[12:16])
+	}
+	return codes, nil
+}
+
+// HashRecoveryCode returns the hash of a recovery code that is stored instead of the code. The codes are random, so
+// an unsalted hash is sufficient. Case, spaces and dashes are ignored.
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(code)
+	code = strings.NewReplacer("-", "", " ", "").Replace(code)
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchRecoveryCode returns the index of the hash of the code in hashes or -1
+func MatchRecoveryCode(hashes []string, code string) int {
+	hash := []byte(HashRecoveryCode(code))
+	match := -1
+	for i, h := range hashes {
+		if subtle.ConstantTimeCompare([]byte(h), hash) == 1 {
+			match = i
+		}
+	}
+	return match
+}
@@ -0,0 +1,70 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+const (
+	// appPasswordAlphabet leaves out characters that are easily confused, it has 32 characters so every character
+	// adds 5 bits
+	appPasswordAlphabet = "abcdefghijkmnpqrstuvwxyz23456789"
+	// AppPasswordGroups and AppPasswordGroupSize describe the format of app passwords, eg. xxxxx-xxxxx-xxxxx-xxxxx-xxxxx
+	AppPasswordGroups    = 5
+	AppPasswordGroupSize = 5
+)
+
+// GenerateAppPassword returns a random app password with 125 bits of entropy. The groups make it easier to copy the
+// password into the settings of a client.
+func GenerateAppPassword() (string, error) {
+	b := make([]byte, AppPasswordGroups*AppPasswordGroupSize)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	var sb strings.Builder
+	for i, c := range b {
+		if i > 0 && i%AppPasswordGroupSize == 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteByte(appPasswordAlphabet[int(c)%len(appPasswordAlphabet)])
+	}
+	return sb.String(), nil
+}
+
+// HashAppPassword returns the SHA-256 hash of an app password in hex. App passwords are random and long enough, so
+// unlike user chosen passwords they do not need a slow hash.
+func HashAppPassword(password string) string {
+	sum := sha256.Sum256([]byte(normalizeAppPassword(password)))
+	return hex.EncodeToString(sum[:])
+}
+
+// MatchAppPassword returns the index of the hash matching the app password, or -1
+func MatchAppPassword(hashes []string, password string) int {
+	h := []byte(HashAppPassword(password))
+	match := -1
+	for i, hash := range hashes {
+		if subtle.ConstantTimeCompare([]byte(hash), h) == 1 && match < 0 {
+			match = i
+		}
+	}
+	return match
+}
+
+// normalizeAppPassword allows clients to send the password without dashes or in upper case
+func normalizeAppPassword(password string) string {
+	password = strings.ToLower(strings.TrimSpace(password))
+	if strings.Contains(password, "-") {
+		return password
+	}
+	var sb strings.Builder
+	for i, r := range password {
+		if i > 0 && i%AppPasswordGroupSize == 0 {
+			sb.WriteByte('-')
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
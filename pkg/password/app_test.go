@@ -0,0 +1,19 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppPassword(t *testing.T) {
+	pwd, err := GenerateAppPassword()
+	assert.NoError(t, err)
+	assert.Len(t, pwd, AppPasswordGroups*(AppPasswordGroupSize+1)-1)
+
+	hashes := []string{HashAppPassword("other"), HashAppPassword(pwd)}
+	assert.Equal(t, 1, MatchAppPassword(hashes, pwd))
+	assert.Equal(t, 1, MatchAppPassword(hashes, strings.ToUpper(strings.ReplaceAll(pwd, "-", ""))), "dashes and case are ignored")
+	assert.Equal(t, -1, MatchAppPassword(hashes, pwd[1:]))
+}
@@ -0,0 +1,106 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// argon2id parameters, only the number of iterations is configurable
+const (
+	defaultArgon2idIterations = 3
+	argon2idMemory            = 64 * 1024
+	argon2idThreads           = 2
+	argon2idKeyLength         = 32
+	argon2idSaltLength        = 16
+)
+
+// argon2idHasher generates hashes in the PHC string format, eg. `$argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>`
+type argon2idHasher struct {
+	iterations int
+}
+
+type argon2idParams struct {
+	memory     uint32
+	iterations uint32
+	threads    uint8
+	salt       []byte
+	key        []byte
+}
+
+func newArgon2id(iterations int) *argon2idHasher {
+	if iterations == 0 {
+		iterations = defaultArgon2idIterations
+	}
+	return &argon2idHasher{iterations: iterations}
+}
+
+func (h *argon2idHasher) validate() error {
+	if h.iterations < 1 || h.iterations > 100 {
+		return fmt.Errorf("argon2id iterations must be between 1 and 100")
+	}
+	return nil
+}
+
+func (h *argon2idHasher) Handles(hash string) bool {
+	return strings.HasPrefix(hash, "$argon2id$")
+}
+
+func (h *argon2idHasher) Verify(hash string, password string) bool {
+	p, err := parseArgon2id(hash)
+	if err != nil {
+		return false
+	}
+	key := argon2.IDKey([]byte(password), p.salt, p.iterations, p.memory, p.threads, uint32(len(p.key)))
+	return subtle.ConstantTimeCompare(key, p.key) == 1
+}
+
+func (h *argon2idHasher) Hash(password string) (string, error) {
+	s, err := salt(argon2idSaltLength)
+	if err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), s, uint32(h.iterations), argon2idMemory, argon2idThreads, argon2idKeyLength)
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, argon2idMemory, h.iterations, argon2idThreads,
+		base64.RawStdEncoding.EncodeToString(s), base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *argon2idHasher) NeedsRehash(hash string) bool {
+	p, err := parseArgon2id(hash)
+	return err != nil || p.memory != argon2idMemory || p.iterations != uint32(h.iterations) || p.threads != argon2idThreads
+}
+
+func parseArgon2id(hash string) (*argon2idParams, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 {
+		return nil, fmt.Errorf("invalid argon2id hash")
+	}
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil {
+		return nil, err
+	}
+	if version != argon2.Version {
+		return nil, fmt.Errorf("unsupported argon2 version %d", version)
+	}
+	p := &argon2idParams{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &p.memory, &p.iterations, &p.threads); err != nil {
+		return nil, err
+	}
+	// don't let a stored hash exhaust the memory
+	if p.memory > 4*argon2idMemory || p.iterations < 1 || p.threads < 1 {
+		return nil, fmt.Errorf("argon2id parameters out of range")
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, err
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
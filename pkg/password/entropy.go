@@ -0,0 +1,126 @@
+package password
+
+import (
+	"math"
+	"unicode"
+)
+
+// sizes of the character classes used to estimate the entropy
+const (
+	lowerPool = 26
+	upperPool = 26
+	digitPool = 10
+	otherPool = 33
+	// non ASCII characters are rated as if they were taken from a larger alphabet
+	unicodePool = 100
+	// minPatternLength is the minimum length of repeated characters and sequences
+	minPatternLength = 3
+)
+
+// keyboard rows used to detect sequences like qwerty or asdf
+var keyboardRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]\\",
+	"asdfghjkl;'",
+	"zxcvbnm,./",
+	"qwertzuiopü+",
+	"asdfghjklöä#",
+	"yxcvbnm,.-",
+	"azertyuiop",
+	"qsdfghjklm",
+	"wxcvbn",
+}
+
+// Entropy estimates the entropy of the password in bits. Like zxcvbn, it rates patterns lower than random
+// characters: every character adds the bits of the character classes used by the password, but repeated characters
+// like aaaa, sequences like abcd or 4321 and keyboard sequences like qwerty only add the bits of their first character
+// and their length.
+func Entropy(password string) float64 {
+	runes := []rune(password)
+	if len(runes) == 0 {
+		return 0
+	}
+	bits := math.Log2(float64(pool(runes)))
+
+	var entropy float64
+	for i := 0; i < len(runes); {
+		if n := patternLength(runes[i:]); n >= minPatternLength {
+			entropy += bits + math.Log2(float64(n))
+			i += n
+			continue
+		}
+		entropy += bits
+		i++
+	}
+	return entropy
+}
+
+// pool returns the number of characters in the character classes used by the password
+func pool(runes []rune) int {
+	var lower, upper, digit, other, nonASCII bool
+	for _, r := range runes {
+		switch {
+		case r > unicode.MaxASCII:
+			nonASCII = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+	n := 0
+	for _, c := range []struct {
+		used bool
+		size int
+	}{{lower, lowerPool}, {upper, upperPool}, {digit, digitPool}, {other, otherPool}, {nonASCII, unicodePool}} {
+		if c.used {
+			n += c.size
+		}
+	}
+	return n
+}
+
+// patternLength returns the length of the longest run of repeated characters or of a sequence at the start of runes
+func patternLength(runes []rune) int {
+	if len(runes) < 2 {
+		return len(runes)
+	}
+	longest := 1
+	for _, next := range []func(a, b rune) bool{
+		func(a, b rune) bool { return a == b },
+		func(a, b rune) bool { return b == a+1 },
+		func(a, b rune) bool { return b == a-1 },
+		func(a, b rune) bool { return adjacentKeys(a, b, 1) },
+		func(a, b rune) bool { return adjacentKeys(a, b, -1) },
+	} {
+		n := 1
+		for n < len(runes) && next(runes[n-1], runes[n]) {
+			n++
+		}
+		if n > longest {
+			longest = n
+		}
+	}
+	return longest
+}
+
+// adjacentKeys returns true if b is next to a in one of the keyboard rows, in the given direction
+func adjacentKeys(a, b rune, direction int) bool {
+	a, b = unicode.ToLower(a), unicode.ToLower(b)
+	for _, row := range keyboardRows {
+		keys := []rune(row)
+		for i, k := range keys {
+			if k != a {
+				continue
+			}
+			if j := i + direction; j >= 0 && j < len(keys) && keys[j] == b {
+				return true
+			}
+		}
+	}
+	return false
+}
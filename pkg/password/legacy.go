@@ -0,0 +1,88 @@
+package password
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"strings"
+
+	"github.com/tredoe/osutil/user/crypt"
+	// register the crypt(3) algorithms
+	_ "github.com/tredoe/osutil/user/crypt/apr1_crypt"
+	_ "github.com/tredoe/osutil/user/crypt/md5_crypt"
+	_ "github.com/tredoe/osutil/user/crypt/sha256_crypt"
+	_ "github.com/tredoe/osutil/user/crypt/sha512_crypt"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// The legacy hashers can only verify hashes, eg. of accounts migrated from an LDAP server
+
+// hasPrefixFold returns true if s starts with the prefix, ignoring the case
+func hasPrefixFold(s string, prefix string) bool {
+	return len(s) >= len(prefix) && strings.EqualFold(s[:len(prefix)], prefix)
+}
+
+// ssha verifies salted SHA-1 hashes, eg. `{SSHA}<base64 of the digest followed by the salt>`
+type ssha struct{}
+
+func (ssha) Handles(hash string) bool {
+	return hasPrefixFold(hash, "{SSHA}")
+}
+
+func (ssha) Verify(hash string, password string) bool {
+	decoded, err := base64.StdEncoding.DecodeString(hash[len("{SSHA}"):])
+	if err != nil || len(decoded) <= sha1.Size {
+		return false
+	}
+	digest, s := decoded[:sha1.Size], decoded[sha1.Size:]
+	sum := sha1.Sum(append([]byte(password), s...))
+	return subtle.ConstantTimeCompare(sum[:], digest) == 1
+}
+
+// sha verifies unsalted SHA-1 hashes, eg. `{SHA}<base64 of the digest>`
+type sha struct{}
+
+func (sha) Handles(hash string) bool {
+	return hasPrefixFold(hash, "{SHA}")
+}
+
+func (sha) Verify(hash string, password string) bool {
+	digest, err := base64.StdEncoding.DecodeString(hash[len("{SHA}"):])
+	if err != nil {
+		return false
+	}
+	sum := sha1.Sum([]byte(password))
+	return subtle.ConstantTimeCompare(sum[:], digest) == 1
+}
+
+// cryptPrefixes are the crypt(3) algorithms that can be verified
+var cryptPrefixes = []string{"$1$", "$5$", "$6$", "$apr1$"}
+
+// cryptHasher verifies crypt(3) hashes, with or without the `{CRYPT}` prefix of LDAP servers. The SHA-512 hashes of
+// older ocis-accounts versions are crypt(3) hashes as well.
+type cryptHasher struct{}
+
+func (cryptHasher) Handles(hash string) bool {
+	hash = trimCryptPrefix(hash)
+	for _, prefix := range cryptPrefixes {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return strings.HasPrefix(hash, "$2")
+}
+
+func (cryptHasher) Verify(hash string, password string) bool {
+	hash = trimCryptPrefix(hash)
+	if strings.HasPrefix(hash, "$2") {
+		return bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)) == nil
+	}
+	return crypt.NewFromHash(hash).Verify(hash, []byte(password)) == nil
+}
+
+func trimCryptPrefix(hash string) string {
+	if hasPrefixFold(hash, "{CRYPT}") {
+		return hash[len("{CRYPT}"):]
+	}
+	return hash
+}
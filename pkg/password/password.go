@@ -0,0 +1,136 @@
+// Package password hashes passwords and verifies them against hashes of different algorithms
+package password
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync"
+)
+
+// Hasher verifies passwords against the hashes of one algorithm
+type Hasher interface {
+	// Handles returns true if the hash was generated by the algorithm of the hasher
+	Handles(hash string) bool
+	// Verify returns true if the password matches the hash
+	Verify(hash string, password string) bool
+}
+
+// Generator is a Hasher that can also generate hashes. Legacy algorithms only implement Hasher, so their hashes are
+// replaced with a hash of the preferred algorithm after the next successful login.
+type Generator interface {
+	Hasher
+	// Hash returns the hash of the password
+	Hash(password string) (string, error)
+	// NeedsRehash returns true if the hash was generated with a different cost
+	NeedsRehash(hash string) bool
+}
+
+// Algorithms that can be used to generate hashes
+const (
+	BCrypt   = "bcrypt"
+	Argon2id = "argon2id"
+	SCrypt   = "scrypt"
+)
+
+// Registry generates hashes with the preferred algorithm and verifies hashes of all known algorithms, the hasher is
+// selected by the prefix of the hash.
+type Registry struct {
+	preferred Generator
+	hashers   []Hasher
+
+	unknownOnce sync.Once
+	unknownHash string
+}
+
+// NewRegistry returns a registry that generates hashes with the given algorithm. An empty algorithm selects bcrypt,
+// a cost of 0 the default cost of the algorithm. The cost is the bcrypt cost, the number of argon2id iterations or
+// the binary logarithm of the scrypt cost parameter N.
+func NewRegistry(algorithm string, cost int) (*Registry, error) {
+	var preferred Generator
+	switch algorithm {
+	case "", BCrypt:
+		preferred = newBCrypt(cost)
+	case Argon2id:
+		preferred = newArgon2id(cost)
+	case SCrypt:
+		preferred = newSCrypt(cost)
+	default:
+		return nil, fmt.Errorf("unknown password hash algorithm %s", algorithm)
+	}
+	if err := validateCost(preferred); err != nil {
+		return nil, err
+	}
+	return &Registry{
+		preferred: preferred,
+		hashers: []Hasher{
+			newBCrypt(0),
+			newArgon2id(0),
+			newSCrypt(0),
+			ssha{},
+			sha{},
+			cryptHasher{},
+		},
+	}, nil
+}
+
+// Hash returns the hash of the password, generated with the preferred algorithm
+func (r *Registry) Hash(password string) (string, error) {
+	return r.preferred.Hash(password)
+}
+
+// Verify checks the password against the hash. When the password matches, rehash is true if the hash was not
+// generated with the preferred algorithm and cost and should be replaced.
+func (r *Registry) Verify(hash string, password string) (ok bool, rehash bool) {
+	if r.preferred.Handles(hash) {
+		if !safeVerify(r.preferred, hash, password) {
+			return false, false
+		}
+		return true, r.preferred.NeedsRehash(hash)
+	}
+	for _, h := range r.hashers {
+		if h.Handles(hash) {
+			return safeVerify(h, hash, password), true
+		}
+	}
+	return false, false
+}
+
+// VerifyUnknown compares the password against a hash of the preferred algorithm no password matches. It is used for
+// unknown accounts, so they take as long to check as known accounts.
+func (r *Registry) VerifyUnknown(password string) {
+	r.unknownOnce.Do(func() {
+		secret := make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return
+		}
+		r.unknownHash, _ = r.preferred.Hash(string(secret))
+	})
+	safeVerify(r.preferred, r.unknownHash, password)
+}
+
+// validateCost checks the cost of generators that restrict it
+func validateCost(g Generator) error {
+	if v, ok := g.(interface{ validate() error }); ok {
+		return v.validate()
+	}
+	return nil
+}
+
+// safeVerify treats hashes the hasher panics on as not matching
+func safeVerify(h Hasher, hash string, password string) (ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			ok = false
+		}
+	}()
+	return h.Verify(hash, password)
+}
+
+// salt returns n random bytes
+func salt(n int) ([]byte, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
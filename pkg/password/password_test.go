@@ -0,0 +1,110 @@
+package password
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestHashAndVerify(t *testing.T) {
+	var scenarios = []struct {
+		algorithm string
+		cost      int
+		prefix    string
+	}{
+		{BCrypt, bcrypt.MinCost, "$2a$04$"},
+		{Argon2id, 1, "$argon2id$v=19$m=65536,t=1,p=2$"},
+		{SCrypt, 10, "$scrypt$ln=10,r=8,p=1$"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.algorithm, func(t *testing.T) {
+			r, err := NewRegistry(scenario.algorithm, scenario.cost)
+			assert.NoError(t, err)
+
+			hash, err := r.Hash("secret")
+			assert.NoError(t, err)
+			assert.Contains(t, hash, scenario.prefix)
+
+			ok, rehash := r.Verify(hash, "secret")
+			assert.True(t, ok)
+			assert.False(t, rehash)
+
+			ok, _ = r.Verify(hash, "Secret")
+			assert.False(t, ok)
+
+			// hashes with a different cost are upgraded
+			other, err := NewRegistry(scenario.algorithm, scenario.cost+1)
+			assert.NoError(t, err)
+			ok, rehash = other.Verify(hash, "secret")
+			assert.True(t, ok)
+			assert.True(t, rehash)
+		})
+	}
+}
+
+func TestVerifyOtherAlgorithms(t *testing.T) {
+	r, err := NewRegistry(SCrypt, 10)
+	assert.NoError(t, err)
+
+	bcryptHash, err := bcrypt.GenerateFromPassword([]byte("secret"), bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	var scenarios = []struct {
+		name string
+		hash string
+	}{
+		{"bcrypt", string(bcryptHash)},
+		{"sha", "{SHA}5en6G6MezRroT3XKqkdPOmY/BfQ="},
+		{"ssha", "{SSHA}1G904nLkTkGWjKNnQuB/hpWXC/hzYWx0c2FsdA=="},
+		{"lowercase ssha", "{ssha}1G904nLkTkGWjKNnQuB/hpWXC/hzYWx0c2FsdA=="},
+		{"sha512 crypt", "{CRYPT}$6$saltsalt$TVLlQcbpFVof5W3Yz4DTP6gRstiNuHwwTt6GLc1E5n0U0aDehy0S5knV8wiOQSpT0Y77vwPZN.Pq.H91p5hVO1"},
+		{"sha512 crypt without prefix", "$6$saltsalt$TVLlQcbpFVof5W3Yz4DTP6gRstiNuHwwTt6GLc1E5n0U0aDehy0S5knV8wiOQSpT0Y77vwPZN.Pq.H91p5hVO1"},
+		{"sha256 crypt", "{CRYPT}$5$saltsalt$0IyaXrmV7.sGNS6tirgqHLqX/G.FBvgkYA.lpPdS5sA"},
+		{"md5 crypt", "{CRYPT}$1$saltsalt$9xy1btjgzLYfb7hivXtC//"},
+		{"bcrypt crypt", "{CRYPT}" + string(bcryptHash)},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			ok, rehash := r.Verify(scenario.hash, "secret")
+			assert.True(t, ok)
+			assert.True(t, rehash, "hashes of other algorithms are upgraded")
+
+			ok, rehash = r.Verify(scenario.hash, "wrong")
+			assert.False(t, ok)
+			assert.False(t, rehash)
+		})
+	}
+}
+
+func TestInvalidHashes(t *testing.T) {
+	r, err := NewRegistry("", 0)
+	assert.NoError(t, err)
+
+	for _, hash := range []string{
+		"",
+		"secret",
+		"{MD5}Xr4ilOzQ4PCOq3aQ0qbuaQ==",
+		"$argon2id$v=19$m=65536,t=1",
+		"$argon2id$v=19$m=1048576000,t=1,p=2$c2FsdA$a2V5",
+		"$scrypt$ln=30,r=8,p=1$c2FsdA$a2V5",
+		"{SSHA}not base64",
+		"$2a$04$short",
+	} {
+		ok, _ := r.Verify(hash, "secret")
+		assert.False(t, ok, hash)
+	}
+}
+
+func TestNewRegistry(t *testing.T) {
+	_, err := NewRegistry("md5", 0)
+	assert.Error(t, err)
+	_, err = NewRegistry(BCrypt, 100)
+	assert.Error(t, err)
+	_, err = NewRegistry(SCrypt, 30)
+	assert.Error(t, err)
+	_, err = NewRegistry(Argon2id, -1)
+	assert.Error(t, err)
+}
@@ -0,0 +1,203 @@
+package password
+
+import (
+	"bufio"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Rules of the password policy, used in violations
+const (
+	RuleMinLength        = "min_length"
+	RuleCharacterClasses = "character_classes"
+	RuleEntropy          = "entropy"
+	RuleBreached         = "breached"
+	RulePersonalData     = "personal_data"
+	RuleHistory          = "history"
+)
+
+const (
+	// personal data is checked if it has at least this many characters, words of it if they have at least
+	// minPersonalWordLength characters
+	minPersonalValueLength = 3
+	minPersonalWordLength  = 4
+	// characterClassesInTotal is the number of character classes: lower, upper, digit and other
+	characterClassesInTotal = 4
+	sha1HexLength           = 2 * sha1.Size
+)
+
+// Policy describes the requirements for new passwords. Rules with a zero value are not checked.
+type Policy struct {
+	// MinLength is the minimum number of characters
+	MinLength int
+	// MinCharacterClasses is the minimum number of lower case letters, upper case letters, digits and other
+	// characters that have to be used
+	MinCharacterClasses int
+	// MinEntropy is the minimum estimated entropy in bits, see Entropy
+	MinEntropy float64
+	// DisallowPersonalData rejects passwords containing the personal data passed to Check, like the username or mail
+	DisallowPersonalData bool
+	// Breached contains passwords known from data breaches
+	Breached Breached
+}
+
+// Violation is a rule of the policy a password does not meet
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+func (v Violation) String() string {
+	return v.Rule + ": " + v.Message
+}
+
+// Violations is the list of rules a password does not meet
+type Violations []Violation
+
+func (vs Violations) Error() string {
+	msgs := make([]string, 0, len(vs))
+	for _, v := range vs {
+		msgs = append(msgs, v.String())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Check returns the rules the password violates, or nil. The personal data, eg. the username, mail or display name,
+// must not be contained in the password. Values are also split into words, so neither the local part nor the domain
+// of a mail can be used.
+func (p *Policy) Check(password string, personal ...string) Violations {
+	if p == nil {
+		return nil
+	}
+	var vs Violations
+	if n := utf8.RuneCountInString(password); n < p.MinLength {
+		vs = append(vs, Violation{RuleMinLength, fmt.Sprintf("must contain at least %d characters", p.MinLength)})
+	}
+	if n := characterClasses(password); n < p.MinCharacterClasses {
+		vs = append(vs, Violation{RuleCharacterClasses, fmt.Sprintf("must contain at least %d of lower case letters, upper case letters, digits and other characters", p.MinCharacterClasses)})
+	}
+	if p.MinEntropy > 0 && Entropy(password) < p.MinEntropy {
+		vs = append(vs, Violation{RuleEntropy, "is too easy to guess"})
+	}
+	if p.Breached.Contains(password) {
+		vs = append(vs, Violation{RuleBreached, "is known from a data breach"})
+	}
+	if p.DisallowPersonalData {
+		if v := containedPersonalData(password, personal); v != "" {
+			vs = append(vs, Violation{RulePersonalData, fmt.Sprintf("must not contain '%s'", v)})
+		}
+	}
+	return vs
+}
+
+// Validate checks the policy for contradicting rules
+func (p *Policy) Validate() error {
+	if p.MinLength < 0 || p.MinCharacterClasses < 0 || p.MinEntropy < 0 {
+		return fmt.Errorf("password policy rules must not be negative")
+	}
+	if p.MinCharacterClasses > characterClassesInTotal {
+		return fmt.Errorf("password policy requires %d character classes, there are only %d", p.MinCharacterClasses, characterClassesInTotal)
+	}
+	return nil
+}
+
+// characterClasses returns the number of character classes used by the password
+func characterClasses(password string) int {
+	var lower, upper, digit, other int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			other = 1
+		}
+	}
+	return lower + upper + digit + other
+}
+
+// containedPersonalData returns the first personal value or word of a value contained in the password
+func containedPersonalData(password string, personal []string) string {
+	password = strings.ToLower(password)
+	for _, value := range personal {
+		value = strings.ToLower(strings.TrimSpace(value))
+		if utf8.RuneCountInString(value) >= minPersonalValueLength && strings.Contains(password, value) {
+			return value
+		}
+		for _, word := range strings.FieldsFunc(value, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		}) {
+			if utf8.RuneCountInString(word) >= minPersonalWordLength && strings.Contains(password, word) {
+				return word
+			}
+		}
+	}
+	return ""
+}
+
+// Breached is a set of SHA-1 hashes of breached passwords
+type Breached map[string]struct{}
+
+// Contains returns true if the password is in the set
+func (b Breached) Contains(password string) bool {
+	if len(b) == 0 {
+		return false
+	}
+	_, ok := b[sha1Hex(password)]
+	return ok
+}
+
+// ReadBreached reads a list of breached passwords with one password per line. Lines can also contain the SHA-1 hash
+// of a password in hex, optionally followed by a colon and the number of occurrences as in the lists of
+// haveibeenpwned.com.
+func ReadBreached(r io.Reader) (Breached, error) {
+	b := Breached{}
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if line == "" {
+			continue
+		}
+		if h := strings.SplitN(line, ":", 2)[0]; isSHA1Hex(h) {
+			b[strings.ToUpper(h)] = struct{}{}
+			continue
+		}
+		b[sha1Hex(line)] = struct{}{}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// ReadBreachedFile reads a list of breached passwords from a file, see ReadBreached
+func ReadBreachedFile(path string) (Breached, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ReadBreached(f)
+}
+
+func sha1Hex(password string) string {
+	sum := sha1.Sum([]byte(password))
+	return strings.ToUpper(hex.EncodeToString(sum[:]))
+}
+
+func isSHA1Hex(s string) bool {
+	if len(s) != sha1HexLength {
+		return false
+	}
+	_, err := hex.DecodeString(s)
+	return err == nil
+}
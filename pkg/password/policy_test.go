@@ -0,0 +1,60 @@
+package password
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPolicyCheck(t *testing.T) {
+	breached, err := ReadBreached(strings.NewReader("Password1!\n\n5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8:3730471\n"))
+	assert.NoError(t, err)
+	assert.Len(t, breached, 2)
+
+	p := &Policy{MinLength: 8, MinCharacterClasses: 3, MinEntropy: 40, DisallowPersonalData: true, Breached: breached}
+	personal := []string{"einstein", "albert@example.org", "Albert Einstein"}
+
+	var scenarios = []struct {
+		password string
+		rules    []string
+	}{
+		{"Gravity-Waves-1915", nil},
+		{"E=mc2!", []string{RuleMinLength, RuleEntropy}},
+		{"relativity", []string{RuleCharacterClasses}},
+		{"Qwertyuiop123", []string{RuleEntropy}},
+		{"Password1!", []string{RuleBreached}},
+		{"password", []string{RuleCharacterClasses, RuleEntropy, RuleBreached}},
+		{"My-Einstein-42", []string{RulePersonalData}},
+		{"Example-Domain-42", []string{RulePersonalData}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.password, func(t *testing.T) {
+			var rules []string
+			for _, v := range p.Check(scenario.password, personal...) {
+				rules = append(rules, v.Rule)
+			}
+			assert.Equal(t, scenario.rules, rules)
+		})
+	}
+
+	var empty *Policy
+	assert.Empty(t, empty.Check("a"))
+	assert.Empty(t, (&Policy{}).Check("a"), "rules with a zero value are not checked")
+}
+
+func TestEntropy(t *testing.T) {
+	assert.Zero(t, Entropy(""))
+	assert.Less(t, Entropy("aaaaaaaaaaaa"), Entropy("apqmzkxyrtbe"))
+	assert.Less(t, Entropy("abcdefghijkl"), Entropy("apqmzkxyrtbe"))
+	assert.Less(t, Entropy("lkjihgfedcba"), Entropy("apqmzkxyrtbe"))
+	assert.Less(t, Entropy("qwertyuiopas"), Entropy("apqmzkxyrtbe"))
+	assert.Less(t, Entropy("apqmzkxyrtbe"), Entropy("apqmZkxy7tb!"))
+}
+
+func TestPolicyValidate(t *testing.T) {
+	assert.NoError(t, (&Policy{MinLength: 8, MinCharacterClasses: 4}).Validate())
+	assert.Error(t, (&Policy{MinCharacterClasses: 5}).Validate())
+	assert.Error(t, (&Policy{MinLength: -1}).Validate())
+}
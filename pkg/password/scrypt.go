@@ -0,0 +1,106 @@
+package password
+
+import (
+	"crypto/subtle"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// scrypt parameters, only the cost parameter N is configurable, as its binary logarithm
+const (
+	defaultSCryptLogN = 15
+	maxSCryptLogN     = 20
+	scryptR           = 8
+	scryptP           = 1
+	scryptKeyLength   = 32
+	scryptSaltLength  = 16
+)
+
+// scryptHasher generates hashes in the PHC string format, eg. `$scrypt$ln=15,r=8,p=1$<salt>$<key>`
+type scryptHasher struct {
+	logN int
+}
+
+type scryptParams struct {
+	logN int
+	r    int
+	p    int
+	salt []byte
+	key  []byte
+}
+
+func newSCrypt(logN int) *scryptHasher {
+	if logN == 0 {
+		logN = defaultSCryptLogN
+	}
+	return &scryptHasher{logN: logN}
+}
+
+func (h *scryptHasher) validate() error {
+	if h.logN < 10 || h.logN > maxSCryptLogN {
+		return fmt.Errorf("scrypt cost must be between 10 and %d", maxSCryptLogN)
+	}
+	return nil
+}
+
+func (h *scryptHasher) Handles(hash string) bool {
+	return strings.HasPrefix(hash, "$scrypt$")
+}
+
+func (h *scryptHasher) Verify(hash string, password string) bool {
+	p, err := parseSCrypt(hash)
+	if err != nil {
+		return false
+	}
+	key, err := scrypt.Key([]byte(password), p.salt, 1<<uint(p.logN), p.r, p.p, len(p.key))
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare(key, p.key) == 1
+}
+
+func (h *scryptHasher) Hash(password string) (string, error) {
+	s, err := salt(scryptSaltLength)
+	if err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key([]byte(password), s, 1<<uint(h.logN), scryptR, scryptP, scryptKeyLength)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s",
+		h.logN, scryptR, scryptP,
+		base64.RawStdEncoding.EncodeToString(s), base64.RawStdEncoding.EncodeToString(key),
+	), nil
+}
+
+func (h *scryptHasher) NeedsRehash(hash string) bool {
+	p, err := parseSCrypt(hash)
+	return err != nil || p.logN != h.logN || p.r != scryptR || p.p != scryptP
+}
+
+func parseSCrypt(hash string) (*scryptParams, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 5 {
+		return nil, fmt.Errorf("invalid scrypt hash")
+	}
+	p := &scryptParams{}
+	if _, err := fmt.Sscanf(parts[2], "ln=%d,r=%d,p=%d", &p.logN, &p.r, &p.p); err != nil {
+		return nil, err
+	}
+	// don't let a stored hash exhaust the memory
+	if p.logN < 1 || p.logN > maxSCryptLogN || p.r < 1 || p.r > 32 || p.p < 1 || p.p > 16 {
+		return nil, fmt.Errorf("scrypt parameters out of range")
+	}
+	var err error
+	if p.salt, err = base64.RawStdEncoding.DecodeString(parts[3]); err != nil {
+		return nil, err
+	}
+	if p.key, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
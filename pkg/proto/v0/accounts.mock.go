@@ -36,11 +36,26 @@ func mockAccSvc(retErr bool) proto.AccountsService {
 ```
 */
 type MockAccountsService struct {
-	ListFunc   func(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error)
-	GetFunc    func(ctx context.Context, in *GetAccountRequest, opts ...client.CallOption) (*Account, error)
-	CreateFunc func(ctx context.Context, in *CreateAccountRequest, opts ...client.CallOption) (*Account, error)
-	UpdateFunc func(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
-	DeleteFunc func(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	ListFunc                func(ctx context.Context, in *ListAccountsRequest, opts ...client.CallOption) (*ListAccountsResponse, error)
+	GetFunc                 func(ctx context.Context, in *GetAccountRequest, opts ...client.CallOption) (*Account, error)
+	CreateFunc              func(ctx context.Context, in *CreateAccountRequest, opts ...client.CallOption) (*Account, error)
+	UpdateFunc              func(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
+	DeleteFunc              func(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	SearchPrincipalsFunc    func(ctx context.Context, in *SearchPrincipalsRequest, opts ...client.CallOption) (*SearchPrincipalsResponse, error)
+	ValidateQueryFunc       func(ctx context.Context, in *ValidateQueryRequest, opts ...client.CallOption) (*ValidateQueryResponse, error)
+	ExplainQueryFunc        func(ctx context.Context, in *ExplainQueryRequest, opts ...client.CallOption) (*ExplainQueryResponse, error)
+	AuthenticateAccountFunc func(ctx context.Context, in *AuthenticateAccountRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error)
+	UnlockAccountFunc       func(ctx context.Context, in *UnlockAccountRequest, opts ...client.CallOption) (*Account, error)
+	ChangePasswordFunc      func(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error)
+	ChangeOwnPasswordFunc   func(ctx context.Context, in *ChangeOwnPasswordRequest, opts ...client.CallOption) (*Account, error)
+	EnrollTotpFunc          func(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error)
+	VerifyTotpFunc          func(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error)
+	RemoveTotpFunc          func(ctx context.Context, in *RemoveTotpRequest, opts ...client.CallOption) (*Account, error)
+	CreateAppPasswordFunc   func(ctx context.Context, in *CreateAppPasswordRequest, opts ...client.CallOption) (*CreateAppPasswordResponse, error)
+	ListAppPasswordsFunc    func(ctx context.Context, in *ListAppPasswordsRequest, opts ...client.CallOption) (*ListAppPasswordsResponse, error)
+	RevokeAppPasswordFunc   func(ctx context.Context, in *RevokeAppPasswordRequest, opts ...client.CallOption) (*empty.Empty, error)
+	RevokeSessionsFunc      func(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error)
+	ValidateSessionFunc     func(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error)
 }
 
 // ListAccounts will panic if the function has been called, but not mocked
@@ -87,3 +102,138 @@ func (m MockAccountsService) DeleteAccount(ctx context.Context, in *DeleteAccoun
 
 	panic("DeleteFunc was called in test but not mocked")
 }
+
+// SearchPrincipals will panic if the function has been called, but not mocked
+func (m MockAccountsService) SearchPrincipals(ctx context.Context, in *SearchPrincipalsRequest, opts ...client.CallOption) (*SearchPrincipalsResponse, error) {
+	if m.SearchPrincipalsFunc != nil {
+		return m.SearchPrincipalsFunc(ctx, in, opts...)
+	}
+
+	panic("SearchPrincipalsFunc was called in test but not mocked")
+}
+
+// ValidateQuery will panic if the function has been called, but not mocked
+func (m MockAccountsService) ValidateQuery(ctx context.Context, in *ValidateQueryRequest, opts ...client.CallOption) (*ValidateQueryResponse, error) {
+	if m.ValidateQueryFunc != nil {
+		return m.ValidateQueryFunc(ctx, in, opts...)
+	}
+
+	panic("ValidateQueryFunc was called in test but not mocked")
+}
+
+// ExplainQuery will panic if the function has been called, but not mocked
+func (m MockAccountsService) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...client.CallOption) (*ExplainQueryResponse, error) {
+	if m.ExplainQueryFunc != nil {
+		return m.ExplainQueryFunc(ctx, in, opts...)
+	}
+
+	panic("ExplainQueryFunc was called in test but not mocked")
+}
+
+// AuthenticateAccount will panic if the function has been called, but not mocked
+func (m MockAccountsService) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error) {
+	if m.AuthenticateAccountFunc != nil {
+		return m.AuthenticateAccountFunc(ctx, in, opts...)
+	}
+
+	panic("AuthenticateAccountFunc was called in test but not mocked")
+}
+
+// UnlockAccount will panic if the function has been called, but not mocked
+func (m MockAccountsService) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...client.CallOption) (*Account, error) {
+	if m.UnlockAccountFunc != nil {
+		return m.UnlockAccountFunc(ctx, in, opts...)
+	}
+
+	panic("UnlockAccountFunc was called in test but not mocked")
+}
+
+// ChangePassword will panic if the function has been called, but not mocked
+func (m MockAccountsService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error) {
+	if m.ChangePasswordFunc != nil {
+		return m.ChangePasswordFunc(ctx, in, opts...)
+	}
+
+	panic("ChangePasswordFunc was called in test but not mocked")
+}
+
+// ChangeOwnPassword will panic if the function has been called, but not mocked
+func (m MockAccountsService) ChangeOwnPassword(ctx context.Context, in *ChangeOwnPasswordRequest, opts ...client.CallOption) (*Account, error) {
+	if m.ChangeOwnPasswordFunc != nil {
+		return m.ChangeOwnPasswordFunc(ctx, in, opts...)
+	}
+
+	panic("ChangeOwnPasswordFunc was called in test but not mocked")
+}
+
+// EnrollTotp will panic if the function has been called, but not mocked
+func (m MockAccountsService) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error) {
+	if m.EnrollTotpFunc != nil {
+		return m.EnrollTotpFunc(ctx, in, opts...)
+	}
+
+	panic("EnrollTotpFunc was called in test but not mocked")
+}
+
+// VerifyTotp will panic if the function has been called, but not mocked
+func (m MockAccountsService) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error) {
+	if m.VerifyTotpFunc != nil {
+		return m.VerifyTotpFunc(ctx, in, opts...)
+	}
+
+	panic("VerifyTotpFunc was called in test but not mocked")
+}
+
+// RemoveTotp will panic if the function has been called, but not mocked
+func (m MockAccountsService) RemoveTotp(ctx context.Context, in *RemoveTotpRequest, opts ...client.CallOption) (*Account, error) {
+	if m.RemoveTotpFunc != nil {
+		return m.RemoveTotpFunc(ctx, in, opts...)
+	}
+
+	panic("RemoveTotpFunc was called in test but not mocked")
+}
+
+// CreateAppPassword will panic if the function has been called, but not mocked
+func (m MockAccountsService) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...client.CallOption) (*CreateAppPasswordResponse, error) {
+	if m.CreateAppPasswordFunc != nil {
+		return m.CreateAppPasswordFunc(ctx, in, opts...)
+	}
+
+	panic("CreateAppPasswordFunc was called in test but not mocked")
+}
+
+// ListAppPasswords will panic if the function has been called, but not mocked
+func (m MockAccountsService) ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...client.CallOption) (*ListAppPasswordsResponse, error) {
+	if m.ListAppPasswordsFunc != nil {
+		return m.ListAppPasswordsFunc(ctx, in, opts...)
+	}
+
+	panic("ListAppPasswordsFunc was called in test but not mocked")
+}
+
+// RevokeAppPassword will panic if the function has been called, but not mocked
+func (m MockAccountsService) RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...client.CallOption) (*empty.Empty, error) {
+	if m.RevokeAppPasswordFunc != nil {
+		return m.RevokeAppPasswordFunc(ctx, in, opts...)
+	}
+
+	panic("RevokeAppPasswordFunc was called in test but not mocked")
+}
+
+// RevokeSessions will panic if the function has been called, but not mocked
+func (m MockAccountsService) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error) {
+	if m.RevokeSessionsFunc != nil {
+		return m.RevokeSessionsFunc(ctx, in, opts...)
+	}
+
+	panic("RevokeSessionsFunc was called in test but not mocked")
+}
+
+// ValidateSession will panic if the function has been called, but not mocked
+func (m MockAccountsService) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error) {
+	if m.ValidateSessionFunc != nil {
+		return m.ValidateSessionFunc(ctx, in, opts...)
+	}
+
+	panic("ValidateSessionFunc was called in test but not mocked")
+}
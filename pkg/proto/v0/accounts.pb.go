@@ -24,6 +24,103 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+// Reasons an authentication can fail for. Callers should not reveal the reason
+// to the user, so attackers can't tell which accounts exist.
+type AuthenticationFailure int32
+
+const (
+	// The authentication succeeded
+	AuthenticationFailure_NO_FAILURE AuthenticationFailure = 0
+	// No account or more than one account has the login
+	AuthenticationFailure_UNKNOWN_ACCOUNT AuthenticationFailure = 1
+	// The password does not match
+	AuthenticationFailure_INVALID_PASSWORD AuthenticationFailure = 2
+	// The password matches but the account is disabled
+	AuthenticationFailure_ACCOUNT_DISABLED AuthenticationFailure = 3
+	// Too many sign-ins of the account failed, the password is not checked
+	AuthenticationFailure_ACCOUNT_LOCKED AuthenticationFailure = 4
+	// The password matches but it is older than the maximum password age,
+	// it has to be changed with `ChangePassword`
+	AuthenticationFailure_PASSWORD_EXPIRED AuthenticationFailure = 5
+	// Too many sign-ins from the address of the client failed
+	AuthenticationFailure_SOURCE_LOCKED AuthenticationFailure = 6
+	// The password matches but `force_change_password_next_sign_in` is set,
+	// it has to be changed with `ChangePassword`
+	AuthenticationFailure_PASSWORD_CHANGE_REQUIRED AuthenticationFailure = 7
+	// The password matches but the account enrolled TOTP and no code was sent
+	AuthenticationFailure_MFA_REQUIRED AuthenticationFailure = 8
+	// The password matches but the TOTP or recovery code does not
+	AuthenticationFailure_INVALID_MFA_CODE AuthenticationFailure = 9
+	// An application password matches but it is not allowed for the
+	// requested scope
+	AuthenticationFailure_INSUFFICIENT_SCOPE AuthenticationFailure = 10
+)
+
+var AuthenticationFailure_name = map[int32]string{
+	0:  "NO_FAILURE",
+	1:  "UNKNOWN_ACCOUNT",
+	2:  "INVALID_PASSWORD",
+	3:  "ACCOUNT_DISABLED",
+	4:  "ACCOUNT_LOCKED",
+	5:  "PASSWORD_EXPIRED",
+	6:  "SOURCE_LOCKED",
+	7:  "PASSWORD_CHANGE_REQUIRED",
+	8:  "MFA_REQUIRED",
+	9:  "INVALID_MFA_CODE",
+	10: "INSUFFICIENT_SCOPE",
+}
+
+var AuthenticationFailure_value = map[string]int32{
+	"NO_FAILURE":               0,
+	"UNKNOWN_ACCOUNT":          1,
+	"INVALID_PASSWORD":         2,
+	"ACCOUNT_DISABLED":         3,
+	"ACCOUNT_LOCKED":           4,
+	"PASSWORD_EXPIRED":         5,
+	"SOURCE_LOCKED":            6,
+	"PASSWORD_CHANGE_REQUIRED": 7,
+	"MFA_REQUIRED":             8,
+	"INVALID_MFA_CODE":         9,
+	"INSUFFICIENT_SCOPE":       10,
+}
+
+func (x AuthenticationFailure) String() string {
+	return proto.EnumName(AuthenticationFailure_name, int32(x))
+}
+
+func (AuthenticationFailure) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{0}
+}
+
+// The kinds of tokens that can be validated with `ValidateSession`
+type SessionType int32
+
+const (
+	// A sign-in session, eg. a session cookie, checked against
+	// `sign_in_sessions_valid_from_date_time`
+	SessionType_SIGN_IN_SESSION SessionType = 0
+	// A refresh token, checked against `refresh_tokens_valid_from_date_time`
+	SessionType_REFRESH_TOKEN SessionType = 1
+)
+
+var SessionType_name = map[int32]string{
+	0: "SIGN_IN_SESSION",
+	1: "REFRESH_TOKEN",
+}
+
+var SessionType_value = map[string]int32{
+	"SIGN_IN_SESSION": 0,
+	"REFRESH_TOKEN":   1,
+}
+
+func (x SessionType) String() string {
+	return proto.EnumName(SessionType_name, int32(x))
+}
+
+func (SessionType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{1}
+}
+
 type ListAccountsRequest struct {
 	// Optional. The maximum number of accounts to return in the response
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -53,7 +150,36 @@ type ListAccountsRequest struct {
 	// `email` set to `foo@example.com`
 	// * Query `display_name=\\"Test String\\"` returns accounts with
 	// display names that include both "Test" and "String"
-	Query                string   `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. Comma separated list of properties used to sort the accounts,
+	// following the OData `$orderby` syntax. Each property can be followed by
+	// `asc` or `desc`, the default is ascending order.
+	//
+	// Example: `display_name desc, uid_number`
+	//
+	// Sortable properties are `id`, `display_name`, `preferred_name`,
+	// `on_premises_sam_account_name`, `mail`, `uid_number`, `gid_number`
+	// and `created_date_time`.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. Count all accounts matching the query and return the
+	// number in the `total_size` of the response.
+	IncludeTotalSize bool `protobuf:"varint,6,opt,name=include_total_size,json=includeTotalSize,proto3" json:"include_total_size,omitempty"`
+	// Optional. Properties to compute term facets for, counting the accounts
+	// matching the query per distinct value.
+	//
+	// Supported properties are `account_enabled`, `is_resource_account`,
+	// `creation_type`, `memberOf`, `external_user_state`,
+	// `on_premises_sync_enabled` and `on_premises_domain_name`.
+	Facets []string `protobuf:"bytes,7,rep,name=facets,proto3" json:"facets,omitempty"`
+	// Optional. Free text searched in the display_name, preferred_name, mail
+	// and description of the accounts. Results are ranked by relevance unless
+	// `order_by` is given. Can be combined with `query`.
+	Search string `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`
+	// Optional. The language of the `query`. Supported languages are `odata`
+	// (the default), `scim` for SCIM filters like
+	// `userName eq "bjensen" and emails co "example.com"` and `ldap` for
+	// ldap filters like `(&(objectClass=posixAccount)(uid=ein*))`.
+	FilterLanguage       string   `protobuf:"bytes,9,opt,name=filter_language,json=filterLanguage,proto3" json:"filter_language,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -112,6 +238,41 @@ func (m *ListAccountsRequest) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *ListAccountsRequest) GetIncludeTotalSize() bool {
+	if m != nil {
+		return m.IncludeTotalSize
+	}
+	return false
+}
+
+func (m *ListAccountsRequest) GetFacets() []string {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+func (m *ListAccountsRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListAccountsRequest) GetFilterLanguage() string {
+	if m != nil {
+		return m.FilterLanguage
+	}
+	return ""
+}
+
 type ListAccountsResponse struct {
 	// The field name should match the noun "accounts" in the method name.  There
 	// will be a maximum number of items returned based on the page_size field
@@ -119,7 +280,12 @@ type ListAccountsResponse struct {
 	Accounts []*Account `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
 	// Token to retrieve the next page of results, or empty if there are no
 	// more results in the list
-	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of accounts matching the query, only set when
+	// `include_total_size` was requested
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The facets requested in the `facets` field of the request
+	Facets               []*Facet `protobuf:"bytes,4,rep,name=facets,proto3" json:"facets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -148,186 +314,1884 @@ func (m *ListAccountsResponse) XXX_DiscardUnknown() {
 	xxx_messageInfo_ListAccountsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListAccountsResponse proto.InternalMessageInfo
+
+func (m *ListAccountsResponse) GetAccounts() []*Account {
+	if m != nil {
+		return m.Accounts
+	}
+	return nil
+}
+
+func (m *ListAccountsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *ListAccountsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ListAccountsResponse) GetFacets() []*Facet {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+// A term facet counts the records matching a query per distinct value of a
+// property
+type Facet struct {
+	// The property the facet was requested for
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// Number of values counted in the facet
+	Total int32 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	// Number of records without a value for the property
+	Missing int32 `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	// Number of values not returned in terms
+	Other int32 `protobuf:"varint,4,opt,name=other,proto3" json:"other,omitempty"`
+	// The most frequent values
+	Terms                []*FacetTerm `protobuf:"bytes,5,rep,name=terms,proto3" json:"terms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Facet) Reset()         { *m = Facet{} }
+func (m *Facet) String() string { return proto.CompactTextString(m) }
+func (*Facet) ProtoMessage()    {}
+func (*Facet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{2}
+}
+
+func (m *Facet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Facet.Unmarshal(m, b)
+}
+func (m *Facet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Facet.Marshal(b, m, deterministic)
+}
+func (m *Facet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Facet.Merge(m, src)
+}
+func (m *Facet) XXX_Size() int {
+	return xxx_messageInfo_Facet.Size(m)
+}
+func (m *Facet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Facet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Facet proto.InternalMessageInfo
+
+func (m *Facet) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Facet) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *Facet) GetMissing() int32 {
+	if m != nil {
+		return m.Missing
+	}
+	return 0
+}
+
+func (m *Facet) GetOther() int32 {
+	if m != nil {
+		return m.Other
+	}
+	return 0
+}
+
+func (m *Facet) GetTerms() []*FacetTerm {
+	if m != nil {
+		return m.Terms
+	}
+	return nil
+}
+
+type FacetTerm struct {
+	// The value
+	Term string `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	// Number of records with the value
+	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FacetTerm) Reset()         { *m = FacetTerm{} }
+func (m *FacetTerm) String() string { return proto.CompactTextString(m) }
+func (*FacetTerm) ProtoMessage()    {}
+func (*FacetTerm) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{3}
+}
+
+func (m *FacetTerm) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetTerm.Unmarshal(m, b)
+}
+func (m *FacetTerm) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetTerm.Marshal(b, m, deterministic)
+}
+func (m *FacetTerm) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetTerm.Merge(m, src)
+}
+func (m *FacetTerm) XXX_Size() int {
+	return xxx_messageInfo_FacetTerm.Size(m)
+}
+func (m *FacetTerm) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetTerm.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetTerm proto.InternalMessageInfo
+
+func (m *FacetTerm) GetTerm() string {
+	if m != nil {
+		return m.Term
+	}
+	return ""
+}
+
+func (m *FacetTerm) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type SearchPrincipalsRequest struct {
+	// The text to search for. Every word is matched as a prefix against the
+	// display_name, preferred_name, on_premises_sam_account_name and mail of
+	// accounts and the display_name and on_premises_sam_account_name of groups.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The maximum number of principals to return, defaults to 10
+	PageSize             int32    `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchPrincipalsRequest) Reset()         { *m = SearchPrincipalsRequest{} }
+func (m *SearchPrincipalsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchPrincipalsRequest) ProtoMessage()    {}
+func (*SearchPrincipalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{4}
+}
+
+func (m *SearchPrincipalsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPrincipalsRequest.Unmarshal(m, b)
+}
+func (m *SearchPrincipalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPrincipalsRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchPrincipalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPrincipalsRequest.Merge(m, src)
+}
+func (m *SearchPrincipalsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchPrincipalsRequest.Size(m)
+}
+func (m *SearchPrincipalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPrincipalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPrincipalsRequest proto.InternalMessageInfo
+
+func (m *SearchPrincipalsRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *SearchPrincipalsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type SearchPrincipalsResponse struct {
+	// The matching accounts and groups, best matches first
+	Principals           []*Principal `protobuf:"bytes,1,rep,name=principals,proto3" json:"principals,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SearchPrincipalsResponse) Reset()         { *m = SearchPrincipalsResponse{} }
+func (m *SearchPrincipalsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchPrincipalsResponse) ProtoMessage()    {}
+func (*SearchPrincipalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{5}
+}
+
+func (m *SearchPrincipalsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchPrincipalsResponse.Unmarshal(m, b)
+}
+func (m *SearchPrincipalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchPrincipalsResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchPrincipalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchPrincipalsResponse.Merge(m, src)
+}
+func (m *SearchPrincipalsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchPrincipalsResponse.Size(m)
+}
+func (m *SearchPrincipalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchPrincipalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchPrincipalsResponse proto.InternalMessageInfo
+
+func (m *SearchPrincipalsResponse) GetPrincipals() []*Principal {
+	if m != nil {
+		return m.Principals
+	}
+	return nil
+}
+
+// A principal is an account or a group with the minimal set of properties
+// needed to display it
+type Principal struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Either `account` or `group`
+	Type                     string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	DisplayName              string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	PreferredName            string `protobuf:"bytes,4,opt,name=preferred_name,json=preferredName,proto3" json:"preferred_name,omitempty"`
+	OnPremisesSamAccountName string `protobuf:"bytes,5,opt,name=on_premises_sam_account_name,json=onPremisesSamAccountName,proto3" json:"on_premises_sam_account_name,omitempty"`
+	Mail                     string `protobuf:"bytes,6,opt,name=mail,proto3" json:"mail,omitempty"`
+	// The relevance of the principal for the query
+	Score                float64  `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Principal) Reset()         { *m = Principal{} }
+func (m *Principal) String() string { return proto.CompactTextString(m) }
+func (*Principal) ProtoMessage()    {}
+func (*Principal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{6}
+}
+
+func (m *Principal) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Principal.Unmarshal(m, b)
+}
+func (m *Principal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Principal.Marshal(b, m, deterministic)
+}
+func (m *Principal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Principal.Merge(m, src)
+}
+func (m *Principal) XXX_Size() int {
+	return xxx_messageInfo_Principal.Size(m)
+}
+func (m *Principal) XXX_DiscardUnknown() {
+	xxx_messageInfo_Principal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Principal proto.InternalMessageInfo
+
+func (m *Principal) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Principal) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Principal) GetDisplayName() string {
+	if m != nil {
+		return m.DisplayName
+	}
+	return ""
+}
+
+func (m *Principal) GetPreferredName() string {
+	if m != nil {
+		return m.PreferredName
+	}
+	return ""
+}
+
+func (m *Principal) GetOnPremisesSamAccountName() string {
+	if m != nil {
+		return m.OnPremisesSamAccountName
+	}
+	return ""
+}
+
+func (m *Principal) GetMail() string {
+	if m != nil {
+		return m.Mail
+	}
+	return ""
+}
+
+func (m *Principal) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+type ValidateQueryRequest struct {
+	// The query to validate
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The language of the query, `odata` (the default), `scim` or
+	// `ldap`
+	FilterLanguage string `protobuf:"bytes,2,opt,name=filter_language,json=filterLanguage,proto3" json:"filter_language,omitempty"`
+	// Optional. The type of records the query is used for, `account` (the
+	// default) or `group`. Determines the attributes available in SCIM
+	// filters.
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ValidateQueryRequest) Reset()         { *m = ValidateQueryRequest{} }
+func (m *ValidateQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateQueryRequest) ProtoMessage()    {}
+func (*ValidateQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{7}
+}
+
+func (m *ValidateQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateQueryRequest.Unmarshal(m, b)
+}
+func (m *ValidateQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *ValidateQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateQueryRequest.Merge(m, src)
+}
+func (m *ValidateQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateQueryRequest.Size(m)
+}
+func (m *ValidateQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateQueryRequest proto.InternalMessageInfo
+
+func (m *ValidateQueryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ValidateQueryRequest) GetFilterLanguage() string {
+	if m != nil {
+		return m.FilterLanguage
+	}
+	return ""
+}
+
+func (m *ValidateQueryRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type ValidateQueryResponse struct {
+	// True if the query can be used in a list request
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// The reason why the query is invalid
+	Error                *QueryError `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ValidateQueryResponse) Reset()         { *m = ValidateQueryResponse{} }
+func (m *ValidateQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateQueryResponse) ProtoMessage()    {}
+func (*ValidateQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{8}
+}
+
+func (m *ValidateQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateQueryResponse.Unmarshal(m, b)
+}
+func (m *ValidateQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateQueryResponse.Merge(m, src)
+}
+func (m *ValidateQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateQueryResponse.Size(m)
+}
+func (m *ValidateQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidateQueryResponse proto.InternalMessageInfo
+
+func (m *ValidateQueryResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
+	}
+	return false
+}
+
+func (m *ValidateQueryResponse) GetError() *QueryError {
+	if m != nil {
+		return m.Error
+	}
+	return nil
+}
+
+// Describes why a query could not be parsed or translated
+type QueryError struct {
+	// A human readable description of the problem
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	// The offending token, if known
+	Token string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	// The position of the offending token in the query or -1 if unknown
+	Position int32 `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
+	// The operators supported by the filter language of the query
+	SupportedOperators   []string `protobuf:"bytes,4,rep,name=supported_operators,json=supportedOperators,proto3" json:"supported_operators,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *QueryError) Reset()         { *m = QueryError{} }
+func (m *QueryError) String() string { return proto.CompactTextString(m) }
+func (*QueryError) ProtoMessage()    {}
+func (*QueryError) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{9}
+}
+
+func (m *QueryError) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryError.Unmarshal(m, b)
+}
+func (m *QueryError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryError.Marshal(b, m, deterministic)
+}
+func (m *QueryError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryError.Merge(m, src)
+}
+func (m *QueryError) XXX_Size() int {
+	return xxx_messageInfo_QueryError.Size(m)
+}
+func (m *QueryError) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryError.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryError proto.InternalMessageInfo
+
+func (m *QueryError) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *QueryError) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+func (m *QueryError) GetPosition() int32 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *QueryError) GetSupportedOperators() []string {
+	if m != nil {
+		return m.SupportedOperators
+	}
+	return nil
+}
+
+type ExplainQueryRequest struct {
+	// The query to explain
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. The language of the query, `odata` (the default), `scim` or
+	// `ldap`
+	FilterLanguage string `protobuf:"bytes,2,opt,name=filter_language,json=filterLanguage,proto3" json:"filter_language,omitempty"`
+	// Optional. The type of records to search, `account` (the default) or
+	// `group`
+	Type string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	// Optional. The maximum number of hits to explain, defaults to 10
+	PageSize             int32    `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainQueryRequest) Reset()         { *m = ExplainQueryRequest{} }
+func (m *ExplainQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*ExplainQueryRequest) ProtoMessage()    {}
+func (*ExplainQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{10}
+}
+
+func (m *ExplainQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainQueryRequest.Unmarshal(m, b)
+}
+func (m *ExplainQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *ExplainQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainQueryRequest.Merge(m, src)
+}
+func (m *ExplainQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_ExplainQueryRequest.Size(m)
+}
+func (m *ExplainQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainQueryRequest proto.InternalMessageInfo
+
+func (m *ExplainQueryRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+func (m *ExplainQueryRequest) GetFilterLanguage() string {
+	if m != nil {
+		return m.FilterLanguage
+	}
+	return ""
+}
+
+func (m *ExplainQueryRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ExplainQueryRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type ExplainQueryResponse struct {
+	// The parse tree of odata queries, one node per line indented by its depth
+	ParseTree string `protobuf:"bytes,1,opt,name=parse_tree,json=parseTree,proto3" json:"parse_tree,omitempty"`
+	// The generated bleve query as json
+	BleveQuery string `protobuf:"bytes,2,opt,name=bleve_query,json=bleveQuery,proto3" json:"bleve_query,omitempty"`
+	// The tokens the literal values of the query are analyzed into
+	AnalyzedValues []*AnalyzedValue `protobuf:"bytes,3,rep,name=analyzed_values,json=analyzedValues,proto3" json:"analyzed_values,omitempty"`
+	// The matching records with an explanation of their score
+	Hits                 []*ExplainedHit `protobuf:"bytes,4,rep,name=hits,proto3" json:"hits,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ExplainQueryResponse) Reset()         { *m = ExplainQueryResponse{} }
+func (m *ExplainQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainQueryResponse) ProtoMessage()    {}
+func (*ExplainQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{11}
+}
+
+func (m *ExplainQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainQueryResponse.Unmarshal(m, b)
+}
+func (m *ExplainQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *ExplainQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainQueryResponse.Merge(m, src)
+}
+func (m *ExplainQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_ExplainQueryResponse.Size(m)
+}
+func (m *ExplainQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainQueryResponse proto.InternalMessageInfo
+
+func (m *ExplainQueryResponse) GetParseTree() string {
+	if m != nil {
+		return m.ParseTree
+	}
+	return ""
+}
+
+func (m *ExplainQueryResponse) GetBleveQuery() string {
+	if m != nil {
+		return m.BleveQuery
+	}
+	return ""
+}
+
+func (m *ExplainQueryResponse) GetAnalyzedValues() []*AnalyzedValue {
+	if m != nil {
+		return m.AnalyzedValues
+	}
+	return nil
+}
+
+func (m *ExplainQueryResponse) GetHits() []*ExplainedHit {
+	if m != nil {
+		return m.Hits
+	}
+	return nil
+}
+
+// A literal value of a query and the tokens it is searched for in the index
+type AnalyzedValue struct {
+	// The field in the index the value is compared with
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// The value as given in the query
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The analyzer used for the value, empty if the value is used as is
+	Analyzer             string   `protobuf:"bytes,3,opt,name=analyzer,proto3" json:"analyzer,omitempty"`
+	Tokens               []string `protobuf:"bytes,4,rep,name=tokens,proto3" json:"tokens,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AnalyzedValue) Reset()         { *m = AnalyzedValue{} }
+func (m *AnalyzedValue) String() string { return proto.CompactTextString(m) }
+func (*AnalyzedValue) ProtoMessage()    {}
+func (*AnalyzedValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{12}
+}
+
+func (m *AnalyzedValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AnalyzedValue.Unmarshal(m, b)
+}
+func (m *AnalyzedValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AnalyzedValue.Marshal(b, m, deterministic)
+}
+func (m *AnalyzedValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AnalyzedValue.Merge(m, src)
+}
+func (m *AnalyzedValue) XXX_Size() int {
+	return xxx_messageInfo_AnalyzedValue.Size(m)
+}
+func (m *AnalyzedValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_AnalyzedValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AnalyzedValue proto.InternalMessageInfo
+
+func (m *AnalyzedValue) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *AnalyzedValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *AnalyzedValue) GetAnalyzer() string {
+	if m != nil {
+		return m.Analyzer
+	}
+	return ""
+}
+
+func (m *AnalyzedValue) GetTokens() []string {
+	if m != nil {
+		return m.Tokens
+	}
+	return nil
+}
+
+type ExplainedHit struct {
+	Id    string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Score float64 `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
+	// The score explanation of bleve as json
+	Explanation          string   `protobuf:"bytes,3,opt,name=explanation,proto3" json:"explanation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExplainedHit) Reset()         { *m = ExplainedHit{} }
+func (m *ExplainedHit) String() string { return proto.CompactTextString(m) }
+func (*ExplainedHit) ProtoMessage()    {}
+func (*ExplainedHit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{13}
+}
+
+func (m *ExplainedHit) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExplainedHit.Unmarshal(m, b)
+}
+func (m *ExplainedHit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExplainedHit.Marshal(b, m, deterministic)
+}
+func (m *ExplainedHit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainedHit.Merge(m, src)
+}
+func (m *ExplainedHit) XXX_Size() int {
+	return xxx_messageInfo_ExplainedHit.Size(m)
+}
+func (m *ExplainedHit) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainedHit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainedHit proto.InternalMessageInfo
+
+func (m *ExplainedHit) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ExplainedHit) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *ExplainedHit) GetExplanation() string {
+	if m != nil {
+		return m.Explanation
+	}
+	return ""
+}
+
+type AuthenticateAccountRequest struct {
+	// The login of the account, it is matched against the configured login
+	// attributes, eg. `on_premises_sam_account_name` or `mail`
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	// The password of the account
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// A TOTP code or a recovery code, required if the account enrolled TOTP
+	MfaCode string `protobuf:"bytes,3,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	// The scope the authentication is requested for, eg. `webdav`.
+	// Application passwords restricted to other scopes are rejected.
+	Scope                string   `protobuf:"bytes,4,opt,name=scope,proto3" json:"scope,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuthenticateAccountRequest) Reset()         { *m = AuthenticateAccountRequest{} }
+func (m *AuthenticateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateAccountRequest) ProtoMessage()    {}
+func (*AuthenticateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{14}
+}
+
+func (m *AuthenticateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticateAccountRequest.Unmarshal(m, b)
+}
+func (m *AuthenticateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *AuthenticateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateAccountRequest.Merge(m, src)
+}
+func (m *AuthenticateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_AuthenticateAccountRequest.Size(m)
+}
+func (m *AuthenticateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateAccountRequest proto.InternalMessageInfo
+
+func (m *AuthenticateAccountRequest) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *AuthenticateAccountRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *AuthenticateAccountRequest) GetMfaCode() string {
+	if m != nil {
+		return m.MfaCode
+	}
+	return ""
+}
+
+func (m *AuthenticateAccountRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+type AuthenticateAccountResponse struct {
+	// The reason the authentication failed, `NO_FAILURE` if it succeeded
+	Failure AuthenticationFailure `protobuf:"varint,1,opt,name=failure,proto3,enum=settings.AuthenticationFailure" json:"failure,omitempty"`
+	// The authenticated account without the password, only set if the
+	// authentication succeeded
+	Account *Account `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// The application password used to authenticate, if any. Callers should
+	// restrict the session to its scopes.
+	AppPassword          *AppPassword `protobuf:"bytes,3,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *AuthenticateAccountResponse) Reset()         { *m = AuthenticateAccountResponse{} }
+func (m *AuthenticateAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*AuthenticateAccountResponse) ProtoMessage()    {}
+func (*AuthenticateAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{15}
+}
+
+func (m *AuthenticateAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthenticateAccountResponse.Unmarshal(m, b)
+}
+func (m *AuthenticateAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthenticateAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *AuthenticateAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthenticateAccountResponse.Merge(m, src)
+}
+func (m *AuthenticateAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_AuthenticateAccountResponse.Size(m)
+}
+func (m *AuthenticateAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthenticateAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuthenticateAccountResponse proto.InternalMessageInfo
+
+func (m *AuthenticateAccountResponse) GetFailure() AuthenticationFailure {
+	if m != nil {
+		return m.Failure
+	}
+	return AuthenticationFailure_NO_FAILURE
+}
+
+func (m *AuthenticateAccountResponse) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *AuthenticateAccountResponse) GetAppPassword() *AppPassword {
+	if m != nil {
+		return m.AppPassword
+	}
+	return nil
+}
+
+type GetAccountRequest struct {
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Used to specify a subset of fields that should be
+	// returned, all fields are returned without a mask.
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
+func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountRequest) ProtoMessage()    {}
+func (*GetAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{16}
+}
+
+func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+}
+func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+}
+func (m *GetAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountRequest.Size(m)
+}
+func (m *GetAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+
+func (m *GetAccountRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *GetAccountRequest) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type CreateAccountRequest struct {
+	// The account resource to create
+	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
+func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAccountRequest) ProtoMessage()    {}
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{17}
+}
+
+func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+}
+func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+}
+func (m *CreateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAccountRequest.Size(m)
+}
+func (m *CreateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+
+func (m *CreateAccountRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+type UpdateAccountRequest struct {
+	// The account resource which replaces the resource on the server
+	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The update mask applies to the resource. For the `FieldMask` definition,
+	// see https://developers.google.com/protocol-buffers/docs/reference/google.protobuf#fieldmask
+	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
+func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateAccountRequest) ProtoMessage()    {}
+func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{18}
+}
+
+func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateAccountRequest.Unmarshal(m, b)
+}
+func (m *UpdateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateAccountRequest.Merge(m, src)
+}
+func (m *UpdateAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateAccountRequest.Size(m)
+}
+func (m *UpdateAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateAccountRequest proto.InternalMessageInfo
+
+func (m *UpdateAccountRequest) GetAccount() *Account {
+	if m != nil {
+		return m.Account
+	}
+	return nil
+}
+
+func (m *UpdateAccountRequest) GetUpdateMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+type DeleteAccountRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
+func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountRequest) ProtoMessage()    {}
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{19}
+}
+
+func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
+}
+func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountRequest.Merge(m, src)
+}
+func (m *DeleteAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountRequest.Size(m)
+}
+func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
+
+func (m *DeleteAccountRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type UnlockAccountRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnlockAccountRequest) Reset()         { *m = UnlockAccountRequest{} }
+func (m *UnlockAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockAccountRequest) ProtoMessage()    {}
+func (*UnlockAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{20}
+}
+
+func (m *UnlockAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockAccountRequest.Unmarshal(m, b)
+}
+func (m *UnlockAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *UnlockAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockAccountRequest.Merge(m, src)
+}
+func (m *UnlockAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockAccountRequest.Size(m)
+}
+func (m *UnlockAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnlockAccountRequest proto.InternalMessageInfo
+
+func (m *UnlockAccountRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ChangePasswordRequest struct {
+	// The login of the account, see `AuthenticateAccountRequest`
+	Login string `protobuf:"bytes,1,opt,name=login,proto3" json:"login,omitempty"`
+	// The current password of the account
+	CurrentPassword string `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	// The new password, it has to meet the password policy
+	NewPassword string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	// A TOTP code or a recovery code, required if the account enrolled TOTP
+	MfaCode              string   `protobuf:"bytes,4,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{21}
+}
+
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetLogin() string {
+	if m != nil {
+		return m.Login
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetMfaCode() string {
+	if m != nil {
+		return m.MfaCode
+	}
+	return ""
+}
+
+type ChangeOwnPasswordRequest struct {
+	// The current password of the account
+	CurrentPassword string `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	// The new password, it has to meet the password policy
+	NewPassword string `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	// A TOTP or recovery code, required if the account enabled TOTP
+	MfaCode              string   `protobuf:"bytes,3,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangeOwnPasswordRequest) Reset()         { *m = ChangeOwnPasswordRequest{} }
+func (m *ChangeOwnPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangeOwnPasswordRequest) ProtoMessage()    {}
+func (*ChangeOwnPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{22}
+}
+
+func (m *ChangeOwnPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangeOwnPasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangeOwnPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangeOwnPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangeOwnPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeOwnPasswordRequest.Merge(m, src)
+}
+func (m *ChangeOwnPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangeOwnPasswordRequest.Size(m)
+}
+func (m *ChangeOwnPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeOwnPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeOwnPasswordRequest proto.InternalMessageInfo
+
+func (m *ChangeOwnPasswordRequest) GetCurrentPassword() string {
+	if m != nil {
+		return m.CurrentPassword
+	}
+	return ""
+}
+
+func (m *ChangeOwnPasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+func (m *ChangeOwnPasswordRequest) GetMfaCode() string {
+	if m != nil {
+		return m.MfaCode
+	}
+	return ""
+}
+
+type EnrollTotpRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The current password of the account, required when users enroll
+	// TOTP for their own account
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTotpRequest) Reset()         { *m = EnrollTotpRequest{} }
+func (m *EnrollTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpRequest) ProtoMessage()    {}
+func (*EnrollTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{23}
+}
+
+func (m *EnrollTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpRequest.Unmarshal(m, b)
+}
+func (m *EnrollTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpRequest.Marshal(b, m, deterministic)
+}
+func (m *EnrollTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpRequest.Merge(m, src)
+}
+func (m *EnrollTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpRequest.Size(m)
+}
+func (m *EnrollTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTotpRequest proto.InternalMessageInfo
+
+func (m *EnrollTotpRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *EnrollTotpRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type EnrollTotpResponse struct {
+	// The base32 encoded secret to enter into an authenticator app
+	Secret string `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	// The otpauth URI of the secret, to be shown as QR code
+	KeyUri               string   `protobuf:"bytes,2,opt,name=key_uri,json=keyUri,proto3" json:"key_uri,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnrollTotpResponse) Reset()         { *m = EnrollTotpResponse{} }
+func (m *EnrollTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*EnrollTotpResponse) ProtoMessage()    {}
+func (*EnrollTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{24}
+}
+
+func (m *EnrollTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnrollTotpResponse.Unmarshal(m, b)
+}
+func (m *EnrollTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnrollTotpResponse.Marshal(b, m, deterministic)
+}
+func (m *EnrollTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnrollTotpResponse.Merge(m, src)
+}
+func (m *EnrollTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_EnrollTotpResponse.Size(m)
+}
+func (m *EnrollTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnrollTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnrollTotpResponse proto.InternalMessageInfo
+
+func (m *EnrollTotpResponse) GetSecret() string {
+	if m != nil {
+		return m.Secret
+	}
+	return ""
+}
+
+func (m *EnrollTotpResponse) GetKeyUri() string {
+	if m != nil {
+		return m.KeyUri
+	}
+	return ""
+}
+
+type VerifyTotpRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// A TOTP code generated with the enrolled secret
+	Code                 string   `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTotpRequest) Reset()         { *m = VerifyTotpRequest{} }
+func (m *VerifyTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyTotpRequest) ProtoMessage()    {}
+func (*VerifyTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{25}
+}
+
+func (m *VerifyTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTotpRequest.Unmarshal(m, b)
+}
+func (m *VerifyTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTotpRequest.Marshal(b, m, deterministic)
+}
+func (m *VerifyTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTotpRequest.Merge(m, src)
+}
+func (m *VerifyTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_VerifyTotpRequest.Size(m)
+}
+func (m *VerifyTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTotpRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTotpRequest proto.InternalMessageInfo
+
+func (m *VerifyTotpRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *VerifyTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+type VerifyTotpResponse struct {
+	// The recovery codes, each can be used once instead of a TOTP code.
+	// They are only returned once.
+	RecoveryCodes        []string `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTotpResponse) Reset()         { *m = VerifyTotpResponse{} }
+func (m *VerifyTotpResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyTotpResponse) ProtoMessage()    {}
+func (*VerifyTotpResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{26}
+}
+
+func (m *VerifyTotpResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VerifyTotpResponse.Unmarshal(m, b)
+}
+func (m *VerifyTotpResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VerifyTotpResponse.Marshal(b, m, deterministic)
+}
+func (m *VerifyTotpResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTotpResponse.Merge(m, src)
+}
+func (m *VerifyTotpResponse) XXX_Size() int {
+	return xxx_messageInfo_VerifyTotpResponse.Size(m)
+}
+func (m *VerifyTotpResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTotpResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerifyTotpResponse proto.InternalMessageInfo
+
+func (m *VerifyTotpResponse) GetRecoveryCodes() []string {
+	if m != nil {
+		return m.RecoveryCodes
+	}
+	return nil
+}
+
+type CreateAppPasswordRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// A name to recognize the password, eg. the device it is used on
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The scopes the password can be used for, eg. `webdav`. An empty list
+	// allows all scopes.
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The current password of the account, required when users create app
+	// passwords for their own account
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// A TOTP or recovery code, required when users create app passwords for
+	// their own account and enabled TOTP
+	MfaCode              string   `protobuf:"bytes,5,opt,name=mfa_code,json=mfaCode,proto3" json:"mfa_code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAppPasswordRequest) Reset()         { *m = CreateAppPasswordRequest{} }
+func (m *CreateAppPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateAppPasswordRequest) ProtoMessage()    {}
+func (*CreateAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{27}
+}
+
+func (m *CreateAppPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAppPasswordRequest.Unmarshal(m, b)
+}
+func (m *CreateAppPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAppPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateAppPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAppPasswordRequest.Merge(m, src)
+}
+func (m *CreateAppPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateAppPasswordRequest.Size(m)
+}
+func (m *CreateAppPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAppPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAppPasswordRequest proto.InternalMessageInfo
+
+func (m *CreateAppPasswordRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *CreateAppPasswordRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateAppPasswordRequest) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *CreateAppPasswordRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *CreateAppPasswordRequest) GetMfaCode() string {
+	if m != nil {
+		return m.MfaCode
+	}
+	return ""
+}
+
+type CreateAppPasswordResponse struct {
+	// The created application password
+	AppPassword *AppPassword `protobuf:"bytes,1,opt,name=app_password,json=appPassword,proto3" json:"app_password,omitempty"`
+	// The generated password. It is only returned once.
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateAppPasswordResponse) Reset()         { *m = CreateAppPasswordResponse{} }
+func (m *CreateAppPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateAppPasswordResponse) ProtoMessage()    {}
+func (*CreateAppPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{28}
+}
+
+func (m *CreateAppPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateAppPasswordResponse.Unmarshal(m, b)
+}
+func (m *CreateAppPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateAppPasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateAppPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAppPasswordResponse.Merge(m, src)
+}
+func (m *CreateAppPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateAppPasswordResponse.Size(m)
+}
+func (m *CreateAppPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAppPasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAppPasswordResponse proto.InternalMessageInfo
+
+func (m *CreateAppPasswordResponse) GetAppPassword() *AppPassword {
+	if m != nil {
+		return m.AppPassword
+	}
+	return nil
+}
+
+func (m *CreateAppPasswordResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type ListAppPasswordsRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAppPasswordsRequest) Reset()         { *m = ListAppPasswordsRequest{} }
+func (m *ListAppPasswordsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListAppPasswordsRequest) ProtoMessage()    {}
+func (*ListAppPasswordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{29}
+}
+
+func (m *ListAppPasswordsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAppPasswordsRequest.Unmarshal(m, b)
+}
+func (m *ListAppPasswordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAppPasswordsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListAppPasswordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAppPasswordsRequest.Merge(m, src)
+}
+func (m *ListAppPasswordsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListAppPasswordsRequest.Size(m)
+}
+func (m *ListAppPasswordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAppPasswordsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAppPasswordsRequest proto.InternalMessageInfo
+
+func (m *ListAppPasswordsRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+type ListAppPasswordsResponse struct {
+	AppPasswords         []*AppPassword `protobuf:"bytes,1,rep,name=app_passwords,json=appPasswords,proto3" json:"app_passwords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListAppPasswordsResponse) Reset()         { *m = ListAppPasswordsResponse{} }
+func (m *ListAppPasswordsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListAppPasswordsResponse) ProtoMessage()    {}
+func (*ListAppPasswordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{30}
+}
+
+func (m *ListAppPasswordsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAppPasswordsResponse.Unmarshal(m, b)
+}
+func (m *ListAppPasswordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAppPasswordsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListAppPasswordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAppPasswordsResponse.Merge(m, src)
+}
+func (m *ListAppPasswordsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListAppPasswordsResponse.Size(m)
+}
+func (m *ListAppPasswordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAppPasswordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAppPasswordsResponse proto.InternalMessageInfo
+
+func (m *ListAppPasswordsResponse) GetAppPasswords() []*AppPassword {
+	if m != nil {
+		return m.AppPasswords
+	}
+	return nil
+}
+
+type RevokeAppPasswordRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The id of the application password
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeAppPasswordRequest) Reset()         { *m = RevokeAppPasswordRequest{} }
+func (m *RevokeAppPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeAppPasswordRequest) ProtoMessage()    {}
+func (*RevokeAppPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{31}
+}
+
+func (m *RevokeAppPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeAppPasswordRequest.Unmarshal(m, b)
+}
+func (m *RevokeAppPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeAppPasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeAppPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeAppPasswordRequest.Merge(m, src)
+}
+func (m *RevokeAppPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeAppPasswordRequest.Size(m)
+}
+func (m *RevokeAppPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeAppPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeAppPasswordRequest proto.InternalMessageInfo
 
-func (m *ListAccountsResponse) GetAccounts() []*Account {
+func (m *RevokeAppPasswordRequest) GetAccountId() string {
 	if m != nil {
-		return m.Accounts
+		return m.AccountId
 	}
-	return nil
+	return ""
 }
 
-func (m *ListAccountsResponse) GetNextPageToken() string {
+func (m *RevokeAppPasswordRequest) GetId() string {
 	if m != nil {
-		return m.NextPageToken
+		return m.Id
 	}
 	return ""
 }
 
-type GetAccountRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type RevokeSessionsRequest struct {
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetAccountRequest) Reset()         { *m = GetAccountRequest{} }
-func (m *GetAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*GetAccountRequest) ProtoMessage()    {}
-func (*GetAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{2}
+func (m *RevokeSessionsRequest) Reset()         { *m = RevokeSessionsRequest{} }
+func (m *RevokeSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeSessionsRequest) ProtoMessage()    {}
+func (*RevokeSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{32}
 }
 
-func (m *GetAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetAccountRequest.Unmarshal(m, b)
+func (m *RevokeSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeSessionsRequest.Unmarshal(m, b)
 }
-func (m *GetAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetAccountRequest.Marshal(b, m, deterministic)
+func (m *RevokeSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeSessionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetAccountRequest.Merge(m, src)
+func (m *RevokeSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeSessionsRequest.Merge(m, src)
 }
-func (m *GetAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_GetAccountRequest.Size(m)
+func (m *RevokeSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeSessionsRequest.Size(m)
 }
-func (m *GetAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetAccountRequest.DiscardUnknown(m)
+func (m *RevokeSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeSessionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_RevokeSessionsRequest proto.InternalMessageInfo
 
-func (m *GetAccountRequest) GetId() string {
+func (m *RevokeSessionsRequest) GetAccountId() string {
 	if m != nil {
-		return m.Id
+		return m.AccountId
 	}
 	return ""
 }
 
-type CreateAccountRequest struct {
-	// The account resource to create
-	Account              *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ValidateSessionRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// The time the session or token was issued
+	IssuedDateTime       *timestamp.Timestamp `protobuf:"bytes,2,opt,name=issued_date_time,json=issuedDateTime,proto3" json:"issued_date_time,omitempty"`
+	Type                 SessionType          `protobuf:"varint,3,opt,name=type,proto3,enum=settings.SessionType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CreateAccountRequest) Reset()         { *m = CreateAccountRequest{} }
-func (m *CreateAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateAccountRequest) ProtoMessage()    {}
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{3}
+func (m *ValidateSessionRequest) Reset()         { *m = ValidateSessionRequest{} }
+func (m *ValidateSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*ValidateSessionRequest) ProtoMessage()    {}
+func (*ValidateSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{33}
 }
 
-func (m *CreateAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateAccountRequest.Unmarshal(m, b)
+func (m *ValidateSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSessionRequest.Unmarshal(m, b)
 }
-func (m *CreateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateAccountRequest.Marshal(b, m, deterministic)
+func (m *ValidateSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSessionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateAccountRequest.Merge(m, src)
+func (m *ValidateSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSessionRequest.Merge(m, src)
 }
-func (m *CreateAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateAccountRequest.Size(m)
+func (m *ValidateSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_ValidateSessionRequest.Size(m)
 }
-func (m *CreateAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateAccountRequest.DiscardUnknown(m)
+func (m *ValidateSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSessionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_ValidateSessionRequest proto.InternalMessageInfo
 
-func (m *CreateAccountRequest) GetAccount() *Account {
+func (m *ValidateSessionRequest) GetAccountId() string {
 	if m != nil {
-		return m.Account
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *ValidateSessionRequest) GetIssuedDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.IssuedDateTime
 	}
 	return nil
 }
 
-type UpdateAccountRequest struct {
-	// The account resource which replaces the resource on the server
-	Account *Account `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The update mask applies to the resource. For the `FieldMask` definition,
-	// see https://developers.google.com/protocol-buffers/docs/reference/google.protobuf#fieldmask
-	UpdateMask           *field_mask.FieldMask `protobuf:"bytes,2,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+func (m *ValidateSessionRequest) GetType() SessionType {
+	if m != nil {
+		return m.Type
+	}
+	return SessionType_SIGN_IN_SESSION
 }
 
-func (m *UpdateAccountRequest) Reset()         { *m = UpdateAccountRequest{} }
-func (m *UpdateAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateAccountRequest) ProtoMessage()    {}
-func (*UpdateAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{4}
+type ValidateSessionResponse struct {
+	// *true* if the account exists, is enabled and the session or token was
+	// not issued before it was revoked
+	Valid                bool     `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateAccountRequest.Unmarshal(m, b)
+func (m *ValidateSessionResponse) Reset()         { *m = ValidateSessionResponse{} }
+func (m *ValidateSessionResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateSessionResponse) ProtoMessage()    {}
+func (*ValidateSessionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{34}
 }
-func (m *UpdateAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateAccountRequest.Marshal(b, m, deterministic)
+
+func (m *ValidateSessionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateSessionResponse.Unmarshal(m, b)
 }
-func (m *UpdateAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateAccountRequest.Merge(m, src)
+func (m *ValidateSessionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateSessionResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateAccountRequest.Size(m)
+func (m *ValidateSessionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateSessionResponse.Merge(m, src)
 }
-func (m *UpdateAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateAccountRequest.DiscardUnknown(m)
+func (m *ValidateSessionResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateSessionResponse.Size(m)
 }
-
-var xxx_messageInfo_UpdateAccountRequest proto.InternalMessageInfo
-
-func (m *UpdateAccountRequest) GetAccount() *Account {
-	if m != nil {
-		return m.Account
-	}
-	return nil
+func (m *ValidateSessionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateSessionResponse.DiscardUnknown(m)
 }
 
-func (m *UpdateAccountRequest) GetUpdateMask() *field_mask.FieldMask {
+var xxx_messageInfo_ValidateSessionResponse proto.InternalMessageInfo
+
+func (m *ValidateSessionResponse) GetValid() bool {
 	if m != nil {
-		return m.UpdateMask
+		return m.Valid
 	}
-	return nil
+	return false
 }
 
-type DeleteAccountRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+type RemoveTotpRequest struct {
+	AccountId string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	// A TOTP code or a recovery code, required when users remove TOTP
+	// from their own account
+	Code string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	// The current password of the account, required when users remove
+	// TOTP from their own account
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
-func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteAccountRequest) ProtoMessage()    {}
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{5}
+func (m *RemoveTotpRequest) Reset()         { *m = RemoveTotpRequest{} }
+func (m *RemoveTotpRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveTotpRequest) ProtoMessage()    {}
+func (*RemoveTotpRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{35}
 }
 
-func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
+func (m *RemoveTotpRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveTotpRequest.Unmarshal(m, b)
 }
-func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
+func (m *RemoveTotpRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveTotpRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteAccountRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteAccountRequest.Merge(m, src)
+func (m *RemoveTotpRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveTotpRequest.Merge(m, src)
 }
-func (m *DeleteAccountRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteAccountRequest.Size(m)
+func (m *RemoveTotpRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveTotpRequest.Size(m)
 }
-func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
+func (m *RemoveTotpRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveTotpRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
+var xxx_messageInfo_RemoveTotpRequest proto.InternalMessageInfo
 
-func (m *DeleteAccountRequest) GetId() string {
+func (m *RemoveTotpRequest) GetAccountId() string {
 	if m != nil {
-		return m.Id
+		return m.AccountId
+	}
+	return ""
+}
+
+func (m *RemoveTotpRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *RemoveTotpRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
 	}
 	return ""
 }
@@ -425,23 +2289,35 @@ type Account struct {
 	// Any refresh tokens or sessions tokens (session cookies) issued before this time are invalid, and applications will get
 	// an error when using an invalid refresh or sessions token to acquire a delegated access token (to access APIs such as Microsoft Graph).
 	// If this happens, the application will need to acquire a new refresh token by making a request to the authorize endpoint.
-	// Returned only on $select. Read-only. Use invalidateAllRefreshTokens to reset.
+	// Returned only on $select. Read-only. Use `RevokeSessions` to reset, password changes and disabling the account also reset it.
 	RefreshTokensValidFromDateTime *timestamp.Timestamp `protobuf:"bytes,60,opt,name=refresh_tokens_valid_from_date_time,json=refreshTokensValidFromDateTime,proto3" json:"refresh_tokens_valid_from_date_time,omitempty"`
 	// Any refresh tokens or sessions tokens (session cookies) issued before this time are invalid, and applications will get
 	// an error when using an invalid refresh or sessions token to acquire a delegated access token (to access APIs such as Microsoft Graph).
 	// If this happens, the application will need to acquire a new refresh token by making a request to the authorize endpoint.
-	// Read-only. Use revokeSignInSessions to reset.
+	// Read-only. Use `RevokeSessions` to reset, password changes and disabling the account also reset it.
 	SignInSessionsValidFromDateTime *timestamp.Timestamp `protobuf:"bytes,61,opt,name=sign_in_sessions_valid_from_date_time,json=signInSessionsValidFromDateTime,proto3" json:"sign_in_sessions_valid_from_date_time,omitempty"`
-	XXX_NoUnkeyedLiteral            struct{}             `json:"-"`
-	XXX_unrecognized                []byte               `json:"-"`
-	XXX_sizecache                   int32                `json:"-"`
+	// Authentication fails until this time because of too many failed sign-in attempts.
+	// Read-only. Use `UnlockAccount` to reset.
+	LockedUntilDateTime *timestamp.Timestamp `protobuf:"bytes,62,opt,name=locked_until_date_time,json=lockedUntilDateTime,proto3" json:"locked_until_date_time,omitempty"`
+	// The number of failed sign-in attempts since the last successful sign-in.
+	// Read-only. Use `UnlockAccount` to reset.
+	FailedSignInAttempts int32 `protobuf:"varint,63,opt,name=failed_sign_in_attempts,json=failedSignInAttempts,proto3" json:"failed_sign_in_attempts,omitempty"`
+	// The multi-factor authentication of the account. Read-only. Use
+	// `EnrollTotp`, `VerifyTotp` and `RemoveTotp` to change it.
+	MultiFactorProfile *MultiFactorProfile `protobuf:"bytes,64,opt,name=multi_factor_profile,json=multiFactorProfile,proto3" json:"multi_factor_profile,omitempty"`
+	// The application passwords of the account. Read-only. Use
+	// `CreateAppPassword` and `RevokeAppPassword` to change them.
+	AppPasswords         []*AppPassword `protobuf:"bytes,65,rep,name=app_passwords,json=appPasswords,proto3" json:"app_passwords,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
 func (m *Account) Reset()         { *m = Account{} }
 func (m *Account) String() string { return proto.CompactTextString(m) }
 func (*Account) ProtoMessage()    {}
 func (*Account) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{6}
+	return fileDescriptor_e1e7723af4c007b7, []int{36}
 }
 
 func (m *Account) XXX_Unmarshal(b []byte) error {
@@ -616,44 +2492,246 @@ func (m *Account) GetOnPremisesUserPrincipalName() string {
 	return ""
 }
 
-func (m *Account) GetOnPremisesLastSyncDateTime() *timestamp.Timestamp {
+func (m *Account) GetOnPremisesLastSyncDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.OnPremisesLastSyncDateTime
+	}
+	return nil
+}
+
+func (m *Account) GetOnPremisesProvisioningErrors() []*OnPremisesProvisioningError {
+	if m != nil {
+		return m.OnPremisesProvisioningErrors
+	}
+	return nil
+}
+
+func (m *Account) GetExternalUserState() string {
+	if m != nil {
+		return m.ExternalUserState
+	}
+	return ""
+}
+
+func (m *Account) GetExternalUserStateChangeDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExternalUserStateChangeDateTime
+	}
+	return nil
+}
+
+func (m *Account) GetRefreshTokensValidFromDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.RefreshTokensValidFromDateTime
+	}
+	return nil
+}
+
+func (m *Account) GetSignInSessionsValidFromDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.SignInSessionsValidFromDateTime
+	}
+	return nil
+}
+
+func (m *Account) GetLockedUntilDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntilDateTime
+	}
+	return nil
+}
+
+func (m *Account) GetFailedSignInAttempts() int32 {
+	if m != nil {
+		return m.FailedSignInAttempts
+	}
+	return 0
+}
+
+func (m *Account) GetMultiFactorProfile() *MultiFactorProfile {
+	if m != nil {
+		return m.MultiFactorProfile
+	}
+	return nil
+}
+
+func (m *Account) GetAppPasswords() []*AppPassword {
+	if m != nil {
+		return m.AppPasswords
+	}
+	return nil
+}
+
+// AppPassword is a password for a client that cannot use the password of the
+// account, it can be revoked without changing the password of the account.
+type AppPassword struct {
+	// The unique identifier of the application password
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The name to recognize the password, eg. the device it is used on
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The scopes the password can be used for, all scopes if empty
+	Scopes []string `protobuf:"bytes,3,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	// The time the password was created
+	CreatedDateTime *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_date_time,json=createdDateTime,proto3" json:"created_date_time,omitempty"`
+	// The time the password was last used to authenticate
+	LastUsedDateTime *timestamp.Timestamp `protobuf:"bytes,5,opt,name=last_used_date_time,json=lastUsedDateTime,proto3" json:"last_used_date_time,omitempty"`
+	// The hash of the password. Never returned.
+	Hash                 string   `protobuf:"bytes,6,opt,name=hash,proto3" json:"hash,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AppPassword) Reset()         { *m = AppPassword{} }
+func (m *AppPassword) String() string { return proto.CompactTextString(m) }
+func (*AppPassword) ProtoMessage()    {}
+func (*AppPassword) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{37}
+}
+
+func (m *AppPassword) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AppPassword.Unmarshal(m, b)
+}
+func (m *AppPassword) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AppPassword.Marshal(b, m, deterministic)
+}
+func (m *AppPassword) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AppPassword.Merge(m, src)
+}
+func (m *AppPassword) XXX_Size() int {
+	return xxx_messageInfo_AppPassword.Size(m)
+}
+func (m *AppPassword) XXX_DiscardUnknown() {
+	xxx_messageInfo_AppPassword.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AppPassword proto.InternalMessageInfo
+
+func (m *AppPassword) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AppPassword) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AppPassword) GetScopes() []string {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *AppPassword) GetCreatedDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedDateTime
+	}
+	return nil
+}
+
+func (m *AppPassword) GetLastUsedDateTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastUsedDateTime
+	}
+	return nil
+}
+
+func (m *AppPassword) GetHash() string {
+	if m != nil {
+		return m.Hash
+	}
+	return ""
+}
+
+type MultiFactorProfile struct {
+	// *true* if a TOTP secret was enrolled and confirmed with a valid code,
+	// sign-ins require a code then
+	TotpEnabled bool `protobuf:"varint,1,opt,name=totp_enabled,json=totpEnabled,proto3" json:"totp_enabled,omitempty"`
+	// The time the TOTP secret was confirmed
+	TotpEnabledDateTime *timestamp.Timestamp `protobuf:"bytes,2,opt,name=totp_enabled_date_time,json=totpEnabledDateTime,proto3" json:"totp_enabled_date_time,omitempty"`
+	// The number of recovery codes that have not been used yet
+	RemainingRecoveryCodes int32 `protobuf:"varint,3,opt,name=remaining_recovery_codes,json=remainingRecoveryCodes,proto3" json:"remaining_recovery_codes,omitempty"`
+	// The encrypted TOTP secret. Never returned.
+	EncryptedTotpSecret string `protobuf:"bytes,4,opt,name=encrypted_totp_secret,json=encryptedTotpSecret,proto3" json:"encrypted_totp_secret,omitempty"`
+	// The time step of the last accepted TOTP code, used to reject replayed
+	// codes. Never returned.
+	LastTotpStep int64 `protobuf:"varint,5,opt,name=last_totp_step,json=lastTotpStep,proto3" json:"last_totp_step,omitempty"`
+	// The hashes of the unused recovery codes. Never returned.
+	RecoveryCodeHashes   []string `protobuf:"bytes,6,rep,name=recovery_code_hashes,json=recoveryCodeHashes,proto3" json:"recovery_code_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MultiFactorProfile) Reset()         { *m = MultiFactorProfile{} }
+func (m *MultiFactorProfile) String() string { return proto.CompactTextString(m) }
+func (*MultiFactorProfile) ProtoMessage()    {}
+func (*MultiFactorProfile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{38}
+}
+
+func (m *MultiFactorProfile) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MultiFactorProfile.Unmarshal(m, b)
+}
+func (m *MultiFactorProfile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MultiFactorProfile.Marshal(b, m, deterministic)
+}
+func (m *MultiFactorProfile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiFactorProfile.Merge(m, src)
+}
+func (m *MultiFactorProfile) XXX_Size() int {
+	return xxx_messageInfo_MultiFactorProfile.Size(m)
+}
+func (m *MultiFactorProfile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiFactorProfile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiFactorProfile proto.InternalMessageInfo
+
+func (m *MultiFactorProfile) GetTotpEnabled() bool {
 	if m != nil {
-		return m.OnPremisesLastSyncDateTime
+		return m.TotpEnabled
 	}
-	return nil
+	return false
 }
 
-func (m *Account) GetOnPremisesProvisioningErrors() []*OnPremisesProvisioningError {
+func (m *MultiFactorProfile) GetTotpEnabledDateTime() *timestamp.Timestamp {
 	if m != nil {
-		return m.OnPremisesProvisioningErrors
+		return m.TotpEnabledDateTime
 	}
 	return nil
 }
 
-func (m *Account) GetExternalUserState() string {
+func (m *MultiFactorProfile) GetRemainingRecoveryCodes() int32 {
 	if m != nil {
-		return m.ExternalUserState
+		return m.RemainingRecoveryCodes
 	}
-	return ""
+	return 0
 }
 
-func (m *Account) GetExternalUserStateChangeDateTime() *timestamp.Timestamp {
+func (m *MultiFactorProfile) GetEncryptedTotpSecret() string {
 	if m != nil {
-		return m.ExternalUserStateChangeDateTime
+		return m.EncryptedTotpSecret
 	}
-	return nil
+	return ""
 }
 
-func (m *Account) GetRefreshTokensValidFromDateTime() *timestamp.Timestamp {
+func (m *MultiFactorProfile) GetLastTotpStep() int64 {
 	if m != nil {
-		return m.RefreshTokensValidFromDateTime
+		return m.LastTotpStep
 	}
-	return nil
+	return 0
 }
 
-func (m *Account) GetSignInSessionsValidFromDateTime() *timestamp.Timestamp {
+func (m *MultiFactorProfile) GetRecoveryCodeHashes() []string {
 	if m != nil {
-		return m.SignInSessionsValidFromDateTime
+		return m.RecoveryCodeHashes
 	}
 	return nil
 }
@@ -690,7 +2768,7 @@ func (m *Identities) Reset()         { *m = Identities{} }
 func (m *Identities) String() string { return proto.CompactTextString(m) }
 func (*Identities) ProtoMessage()    {}
 func (*Identities) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{7}
+	return fileDescriptor_e1e7723af4c007b7, []int{39}
 }
 
 func (m *Identities) XXX_Unmarshal(b []byte) error {
@@ -746,17 +2824,20 @@ type PasswordProfile struct {
 	// *true* if the user must change her password on the next login; otherwise false.
 	ForceChangePasswordNextSignIn bool `protobuf:"varint,4,opt,name=force_change_password_next_sign_in,json=forceChangePasswordNextSignIn,proto3" json:"force_change_password_next_sign_in,omitempty"`
 	// If *true*, at next sign-in, the user must perform a multi-factor authentication (MFA) before being forced to change their password. The behavior is identical to forceChangePasswordNextSignIn except that the user is required to first perform a multi-factor authentication before password change. After a password change, this property will be automatically reset to false. If not set, default is false.
-	ForceChangePasswordNextSignInWithMfa bool     `protobuf:"varint,5,opt,name=force_change_password_next_sign_in_with_mfa,json=forceChangePasswordNextSignInWithMfa,proto3" json:"force_change_password_next_sign_in_with_mfa,omitempty"`
-	XXX_NoUnkeyedLiteral                 struct{} `json:"-"`
-	XXX_unrecognized                     []byte   `json:"-"`
-	XXX_sizecache                        int32    `json:"-"`
+	ForceChangePasswordNextSignInWithMfa bool `protobuf:"varint,5,opt,name=force_change_password_next_sign_in_with_mfa,json=forceChangePasswordNextSignInWithMfa,proto3" json:"force_change_password_next_sign_in_with_mfa,omitempty"`
+	// The hashes of the previous passwords, oldest first. They are used to
+	// reject reusing a password and are never returned.
+	PasswordHistory      []string `protobuf:"bytes,6,rep,name=password_history,json=passwordHistory,proto3" json:"password_history,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *PasswordProfile) Reset()         { *m = PasswordProfile{} }
 func (m *PasswordProfile) String() string { return proto.CompactTextString(m) }
 func (*PasswordProfile) ProtoMessage()    {}
 func (*PasswordProfile) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{8}
+	return fileDescriptor_e1e7723af4c007b7, []int{40}
 }
 
 func (m *PasswordProfile) XXX_Unmarshal(b []byte) error {
@@ -812,6 +2893,13 @@ func (m *PasswordProfile) GetForceChangePasswordNextSignInWithMfa() bool {
 	return false
 }
 
+func (m *PasswordProfile) GetPasswordHistory() []string {
+	if m != nil {
+		return m.PasswordHistory
+	}
+	return nil
+}
+
 type ListGroupsRequest struct {
 	// Optional. The maximum number of groups to return in the response
 	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -839,7 +2927,32 @@ type ListGroupsRequest struct {
 	// starts with "Th"
 	// * Query `display_name=\\"Test String\\"` returns groups with
 	// display names that include both "Test" and "String"
-	Query                string   `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
+	// Optional. Comma separated list of properties used to sort the groups,
+	// following the OData `$orderby` syntax. Each property can be followed by
+	// `asc` or `desc`, the default is ascending order.
+	//
+	// Sortable properties are `id`, `display_name`,
+	// `on_premises_sam_account_name`, `gid_number` and `created_date_time`.
+	OrderBy string `protobuf:"bytes,5,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
+	// Optional. Count all groups matching the query and return the
+	// number in the `total_size` of the response.
+	IncludeTotalSize bool `protobuf:"varint,6,opt,name=include_total_size,json=includeTotalSize,proto3" json:"include_total_size,omitempty"`
+	// Optional. Properties to compute term facets for, counting the groups
+	// matching the query per distinct value.
+	//
+	// Supported properties are `visibility`, `hide_from_address_lists`,
+	// `members`, `on_premises_sync_enabled` and `on_premises_domain_name`.
+	Facets []string `protobuf:"bytes,7,rep,name=facets,proto3" json:"facets,omitempty"`
+	// Optional. Free text searched in the display_name,
+	// on_premises_sam_account_name and description of the groups. Results are
+	// ranked by relevance unless `order_by` is given. Can be combined with
+	// `query`.
+	Search string `protobuf:"bytes,8,opt,name=search,proto3" json:"search,omitempty"`
+	// Optional. The language of the `query`. Supported languages are `odata`
+	// (the default), `scim` for SCIM filters like `displayName sw "phy"` and
+	// `ldap` for ldap filters like `(&(objectClass=posixGroup)(cn=phy*))`.
+	FilterLanguage       string   `protobuf:"bytes,9,opt,name=filter_language,json=filterLanguage,proto3" json:"filter_language,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -849,7 +2962,7 @@ func (m *ListGroupsRequest) Reset()         { *m = ListGroupsRequest{} }
 func (m *ListGroupsRequest) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsRequest) ProtoMessage()    {}
 func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{9}
+	return fileDescriptor_e1e7723af4c007b7, []int{41}
 }
 
 func (m *ListGroupsRequest) XXX_Unmarshal(b []byte) error {
@@ -898,6 +3011,41 @@ func (m *ListGroupsRequest) GetQuery() string {
 	return ""
 }
 
+func (m *ListGroupsRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
+func (m *ListGroupsRequest) GetIncludeTotalSize() bool {
+	if m != nil {
+		return m.IncludeTotalSize
+	}
+	return false
+}
+
+func (m *ListGroupsRequest) GetFacets() []string {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
+func (m *ListGroupsRequest) GetSearch() string {
+	if m != nil {
+		return m.Search
+	}
+	return ""
+}
+
+func (m *ListGroupsRequest) GetFilterLanguage() string {
+	if m != nil {
+		return m.FilterLanguage
+	}
+	return ""
+}
+
 type ListGroupsResponse struct {
 	// The field name should match the noun "group" in the method name.  There
 	// will be a maximum number of items returned based on the page_size field
@@ -905,7 +3053,12 @@ type ListGroupsResponse struct {
 	Groups []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
 	// Token to retrieve the next page of results, or empty if there are no
 	// more results in the list
-	NextPageToken        string   `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+	// Total number of groups matching the query, only set when
+	// `include_total_size` was requested
+	TotalSize int32 `protobuf:"varint,3,opt,name=total_size,json=totalSize,proto3" json:"total_size,omitempty"`
+	// The facets requested in the `facets` field of the request
+	Facets               []*Facet `protobuf:"bytes,4,rep,name=facets,proto3" json:"facets,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -915,7 +3068,7 @@ func (m *ListGroupsResponse) Reset()         { *m = ListGroupsResponse{} }
 func (m *ListGroupsResponse) String() string { return proto.CompactTextString(m) }
 func (*ListGroupsResponse) ProtoMessage()    {}
 func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{10}
+	return fileDescriptor_e1e7723af4c007b7, []int{42}
 }
 
 func (m *ListGroupsResponse) XXX_Unmarshal(b []byte) error {
@@ -950,18 +3103,35 @@ func (m *ListGroupsResponse) GetNextPageToken() string {
 	return ""
 }
 
+func (m *ListGroupsResponse) GetTotalSize() int32 {
+	if m != nil {
+		return m.TotalSize
+	}
+	return 0
+}
+
+func (m *ListGroupsResponse) GetFacets() []*Facet {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
 type GetGroupRequest struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Used to specify a subset of fields that should be
+	// returned, all fields are returned without a mask.
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
 func (m *GetGroupRequest) Reset()         { *m = GetGroupRequest{} }
 func (m *GetGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*GetGroupRequest) ProtoMessage()    {}
 func (*GetGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{11}
+	return fileDescriptor_e1e7723af4c007b7, []int{43}
 }
 
 func (m *GetGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -989,6 +3159,13 @@ func (m *GetGroupRequest) GetId() string {
 	return ""
 }
 
+func (m *GetGroupRequest) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
 type CreateGroupRequest struct {
 	// The account resource to create
 	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
@@ -1001,7 +3178,7 @@ func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
 func (m *CreateGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateGroupRequest) ProtoMessage()    {}
 func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{12}
+	return fileDescriptor_e1e7723af4c007b7, []int{44}
 }
 
 func (m *CreateGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1044,7 +3221,7 @@ func (m *UpdateGroupRequest) Reset()         { *m = UpdateGroupRequest{} }
 func (m *UpdateGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateGroupRequest) ProtoMessage()    {}
 func (*UpdateGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{13}
+	return fileDescriptor_e1e7723af4c007b7, []int{45}
 }
 
 func (m *UpdateGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1090,7 +3267,7 @@ func (m *DeleteGroupRequest) Reset()         { *m = DeleteGroupRequest{} }
 func (m *DeleteGroupRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteGroupRequest) ProtoMessage()    {}
 func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{14}
+	return fileDescriptor_e1e7723af4c007b7, []int{46}
 }
 
 func (m *DeleteGroupRequest) XXX_Unmarshal(b []byte) error {
@@ -1132,7 +3309,7 @@ func (m *AddMemberRequest) Reset()         { *m = AddMemberRequest{} }
 func (m *AddMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*AddMemberRequest) ProtoMessage()    {}
 func (*AddMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{15}
+	return fileDescriptor_e1e7723af4c007b7, []int{47}
 }
 
 func (m *AddMemberRequest) XXX_Unmarshal(b []byte) error {
@@ -1181,7 +3358,7 @@ func (m *RemoveMemberRequest) Reset()         { *m = RemoveMemberRequest{} }
 func (m *RemoveMemberRequest) String() string { return proto.CompactTextString(m) }
 func (*RemoveMemberRequest) ProtoMessage()    {}
 func (*RemoveMemberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{16}
+	return fileDescriptor_e1e7723af4c007b7, []int{48}
 }
 
 func (m *RemoveMemberRequest) XXX_Unmarshal(b []byte) error {
@@ -1244,7 +3421,11 @@ type ListMembersRequest struct {
 	// display names that include both "Test" and "String"
 	Query string `protobuf:"bytes,4,opt,name=query,proto3" json:"query,omitempty"`
 	// The id of the group to list members from
-	Id                   string   `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Id string `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Comma separated list of properties used to sort the members,
+	// following the OData `$orderby` syntax. The same properties as in
+	// `ListAccountsRequest` can be used.
+	OrderBy              string   `protobuf:"bytes,6,opt,name=order_by,json=orderBy,proto3" json:"order_by,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1254,7 +3435,7 @@ func (m *ListMembersRequest) Reset()         { *m = ListMembersRequest{} }
 func (m *ListMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*ListMembersRequest) ProtoMessage()    {}
 func (*ListMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{17}
+	return fileDescriptor_e1e7723af4c007b7, []int{49}
 }
 
 func (m *ListMembersRequest) XXX_Unmarshal(b []byte) error {
@@ -1310,6 +3491,13 @@ func (m *ListMembersRequest) GetId() string {
 	return ""
 }
 
+func (m *ListMembersRequest) GetOrderBy() string {
+	if m != nil {
+		return m.OrderBy
+	}
+	return ""
+}
+
 type ListMembersResponse struct {
 	// The field name should match the noun "members" in the method name.  There
 	// will be a maximum number of items returned based on the page_size field
@@ -1327,7 +3515,7 @@ func (m *ListMembersResponse) Reset()         { *m = ListMembersResponse{} }
 func (m *ListMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*ListMembersResponse) ProtoMessage()    {}
 func (*ListMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{18}
+	return fileDescriptor_e1e7723af4c007b7, []int{50}
 }
 
 func (m *ListMembersResponse) XXX_Unmarshal(b []byte) error {
@@ -1362,6 +3550,274 @@ func (m *ListMembersResponse) GetNextPageToken() string {
 	return ""
 }
 
+type AddMemberGroupRequest struct {
+	// The id of the group to add a member group to
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The id of the group to add as a member
+	MemberGroupId        string   `protobuf:"bytes,2,opt,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddMemberGroupRequest) Reset()         { *m = AddMemberGroupRequest{} }
+func (m *AddMemberGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*AddMemberGroupRequest) ProtoMessage()    {}
+func (*AddMemberGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{51}
+}
+
+func (m *AddMemberGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddMemberGroupRequest.Unmarshal(m, b)
+}
+func (m *AddMemberGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddMemberGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *AddMemberGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddMemberGroupRequest.Merge(m, src)
+}
+func (m *AddMemberGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_AddMemberGroupRequest.Size(m)
+}
+func (m *AddMemberGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddMemberGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddMemberGroupRequest proto.InternalMessageInfo
+
+func (m *AddMemberGroupRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *AddMemberGroupRequest) GetMemberGroupId() string {
+	if m != nil {
+		return m.MemberGroupId
+	}
+	return ""
+}
+
+type RemoveMemberGroupRequest struct {
+	// The id of the group to remove a member group from
+	GroupId string `protobuf:"bytes,1,opt,name=group_id,json=groupId,proto3" json:"group_id,omitempty"`
+	// The id of the member group to remove
+	MemberGroupId        string   `protobuf:"bytes,2,opt,name=member_group_id,json=memberGroupId,proto3" json:"member_group_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveMemberGroupRequest) Reset()         { *m = RemoveMemberGroupRequest{} }
+func (m *RemoveMemberGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveMemberGroupRequest) ProtoMessage()    {}
+func (*RemoveMemberGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{52}
+}
+
+func (m *RemoveMemberGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveMemberGroupRequest.Unmarshal(m, b)
+}
+func (m *RemoveMemberGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveMemberGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveMemberGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMemberGroupRequest.Merge(m, src)
+}
+func (m *RemoveMemberGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveMemberGroupRequest.Size(m)
+}
+func (m *RemoveMemberGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMemberGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMemberGroupRequest proto.InternalMessageInfo
+
+func (m *RemoveMemberGroupRequest) GetGroupId() string {
+	if m != nil {
+		return m.GroupId
+	}
+	return ""
+}
+
+func (m *RemoveMemberGroupRequest) GetMemberGroupId() string {
+	if m != nil {
+		return m.MemberGroupId
+	}
+	return ""
+}
+
+type ListTransitiveMembersRequest struct {
+	// The id of the group to list the members of, including the members of nested groups
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Optional. Used to specify a subset of fields that should be
+	// returned for every member.
+	FieldMask            *field_mask.FieldMask `protobuf:"bytes,2,opt,name=field_mask,json=fieldMask,proto3" json:"field_mask,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ListTransitiveMembersRequest) Reset()         { *m = ListTransitiveMembersRequest{} }
+func (m *ListTransitiveMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTransitiveMembersRequest) ProtoMessage()    {}
+func (*ListTransitiveMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{53}
+}
+
+func (m *ListTransitiveMembersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransitiveMembersRequest.Unmarshal(m, b)
+}
+func (m *ListTransitiveMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransitiveMembersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTransitiveMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransitiveMembersRequest.Merge(m, src)
+}
+func (m *ListTransitiveMembersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTransitiveMembersRequest.Size(m)
+}
+func (m *ListTransitiveMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransitiveMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransitiveMembersRequest proto.InternalMessageInfo
+
+func (m *ListTransitiveMembersRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ListTransitiveMembersRequest) GetFieldMask() *field_mask.FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+type ListTransitiveMembersResponse struct {
+	// The accounts that are members of the group or of one of its nested groups, every account is listed once
+	Members              []*Account `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListTransitiveMembersResponse) Reset()         { *m = ListTransitiveMembersResponse{} }
+func (m *ListTransitiveMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTransitiveMembersResponse) ProtoMessage()    {}
+func (*ListTransitiveMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{54}
+}
+
+func (m *ListTransitiveMembersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransitiveMembersResponse.Unmarshal(m, b)
+}
+func (m *ListTransitiveMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransitiveMembersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTransitiveMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransitiveMembersResponse.Merge(m, src)
+}
+func (m *ListTransitiveMembersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTransitiveMembersResponse.Size(m)
+}
+func (m *ListTransitiveMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransitiveMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransitiveMembersResponse proto.InternalMessageInfo
+
+func (m *ListTransitiveMembersResponse) GetMembers() []*Account {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+type ListTransitiveMemberOfRequest struct {
+	// The id of the account to list the groups of
+	AccountId            string   `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransitiveMemberOfRequest) Reset()         { *m = ListTransitiveMemberOfRequest{} }
+func (m *ListTransitiveMemberOfRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTransitiveMemberOfRequest) ProtoMessage()    {}
+func (*ListTransitiveMemberOfRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{55}
+}
+
+func (m *ListTransitiveMemberOfRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransitiveMemberOfRequest.Unmarshal(m, b)
+}
+func (m *ListTransitiveMemberOfRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransitiveMemberOfRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTransitiveMemberOfRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransitiveMemberOfRequest.Merge(m, src)
+}
+func (m *ListTransitiveMemberOfRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTransitiveMemberOfRequest.Size(m)
+}
+func (m *ListTransitiveMemberOfRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransitiveMemberOfRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransitiveMemberOfRequest proto.InternalMessageInfo
+
+func (m *ListTransitiveMemberOfRequest) GetAccountId() string {
+	if m != nil {
+		return m.AccountId
+	}
+	return ""
+}
+
+type ListTransitiveMemberOfResponse struct {
+	// The groups the account is a member of, directly or through nested groups, every group is listed once
+	Groups               []*Group `protobuf:"bytes,1,rep,name=groups,proto3" json:"groups,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTransitiveMemberOfResponse) Reset()         { *m = ListTransitiveMemberOfResponse{} }
+func (m *ListTransitiveMemberOfResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTransitiveMemberOfResponse) ProtoMessage()    {}
+func (*ListTransitiveMemberOfResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1e7723af4c007b7, []int{56}
+}
+
+func (m *ListTransitiveMemberOfResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTransitiveMemberOfResponse.Unmarshal(m, b)
+}
+func (m *ListTransitiveMemberOfResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTransitiveMemberOfResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTransitiveMemberOfResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTransitiveMemberOfResponse.Merge(m, src)
+}
+func (m *ListTransitiveMemberOfResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTransitiveMemberOfResponse.Size(m)
+}
+func (m *ListTransitiveMemberOfResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTransitiveMemberOfResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTransitiveMemberOfResponse proto.InternalMessageInfo
+
+func (m *ListTransitiveMemberOfResponse) GetGroups() []*Group {
+	if m != nil {
+		return m.Groups
+	}
+	return nil
+}
+
 type Group struct {
 	// The unique identifier for the group.
 	// Returned by default. Inherited from directoryObject. Key. Not nullable. Read-only.
@@ -1429,16 +3885,22 @@ type Group struct {
 	OnPremisesLastSyncDateTime string `protobuf:"bytes,27,opt,name=on_premises_last_sync_date_time,json=onPremisesLastSyncDateTime,proto3" json:"on_premises_last_sync_date_time,omitempty"`
 	// Errors when using synchronization during provisioning.
 	OnPremisesProvisioningErrors []*OnPremisesProvisioningError `protobuf:"bytes,28,rep,name=on_premises_provisioning_errors,json=onPremisesProvisioningErrors,proto3" json:"on_premises_provisioning_errors,omitempty"`
-	XXX_NoUnkeyedLiteral         struct{}                       `json:"-"`
-	XXX_unrecognized             []byte                         `json:"-"`
-	XXX_sizecache                int32                          `json:"-"`
+	// Groups that are members of this group. Nested groups are resolved by `ListTransitiveMembers`
+	// and `ListTransitiveMemberOf`, cycles are rejected when a member group is added.
+	// Read-only, use `AddMemberGroup` and `RemoveMemberGroup` to manage them.
+	MemberGroups []*Group `protobuf:"bytes,29,rep,name=member_groups,json=memberGroups,proto3" json:"member_groups,omitempty"`
+	// Groups this group is a member of. Read-only.
+	MemberOf             []*Group `protobuf:"bytes,30,rep,name=memberOf,proto3" json:"memberOf,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Group) Reset()         { *m = Group{} }
 func (m *Group) String() string { return proto.CompactTextString(m) }
 func (*Group) ProtoMessage()    {}
 func (*Group) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{19}
+	return fileDescriptor_e1e7723af4c007b7, []int{57}
 }
 
 func (m *Group) XXX_Unmarshal(b []byte) error {
@@ -1599,6 +4061,20 @@ func (m *Group) GetOnPremisesProvisioningErrors() []*OnPremisesProvisioningError
 	return nil
 }
 
+func (m *Group) GetMemberGroups() []*Group {
+	if m != nil {
+		return m.MemberGroups
+	}
+	return nil
+}
+
+func (m *Group) GetMemberOf() []*Group {
+	if m != nil {
+		return m.MemberOf
+	}
+	return nil
+}
+
 type OnPremisesProvisioningError struct {
 	// Category of the provisioning error. Note: Currently, there is only one possible value. Possible value: PropertyConflict - indicates a property value is not unique. Other objects contain the same value for the property.
 	Category string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
@@ -1617,7 +4093,7 @@ func (m *OnPremisesProvisioningError) Reset()         { *m = OnPremisesProvision
 func (m *OnPremisesProvisioningError) String() string { return proto.CompactTextString(m) }
 func (*OnPremisesProvisioningError) ProtoMessage()    {}
 func (*OnPremisesProvisioningError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e1e7723af4c007b7, []int{20}
+	return fileDescriptor_e1e7723af4c007b7, []int{58}
 }
 
 func (m *OnPremisesProvisioningError) XXX_Unmarshal(b []byte) error {
@@ -1667,13 +4143,47 @@ func (m *OnPremisesProvisioningError) GetValue() string {
 }
 
 func init() {
+	proto.RegisterEnum("settings.AuthenticationFailure", AuthenticationFailure_name, AuthenticationFailure_value)
+	proto.RegisterEnum("settings.SessionType", SessionType_name, SessionType_value)
 	proto.RegisterType((*ListAccountsRequest)(nil), "settings.ListAccountsRequest")
 	proto.RegisterType((*ListAccountsResponse)(nil), "settings.ListAccountsResponse")
+	proto.RegisterType((*Facet)(nil), "settings.Facet")
+	proto.RegisterType((*FacetTerm)(nil), "settings.FacetTerm")
+	proto.RegisterType((*SearchPrincipalsRequest)(nil), "settings.SearchPrincipalsRequest")
+	proto.RegisterType((*SearchPrincipalsResponse)(nil), "settings.SearchPrincipalsResponse")
+	proto.RegisterType((*Principal)(nil), "settings.Principal")
+	proto.RegisterType((*ValidateQueryRequest)(nil), "settings.ValidateQueryRequest")
+	proto.RegisterType((*ValidateQueryResponse)(nil), "settings.ValidateQueryResponse")
+	proto.RegisterType((*QueryError)(nil), "settings.QueryError")
+	proto.RegisterType((*ExplainQueryRequest)(nil), "settings.ExplainQueryRequest")
+	proto.RegisterType((*ExplainQueryResponse)(nil), "settings.ExplainQueryResponse")
+	proto.RegisterType((*AnalyzedValue)(nil), "settings.AnalyzedValue")
+	proto.RegisterType((*ExplainedHit)(nil), "settings.ExplainedHit")
+	proto.RegisterType((*AuthenticateAccountRequest)(nil), "settings.AuthenticateAccountRequest")
+	proto.RegisterType((*AuthenticateAccountResponse)(nil), "settings.AuthenticateAccountResponse")
 	proto.RegisterType((*GetAccountRequest)(nil), "settings.GetAccountRequest")
 	proto.RegisterType((*CreateAccountRequest)(nil), "settings.CreateAccountRequest")
 	proto.RegisterType((*UpdateAccountRequest)(nil), "settings.UpdateAccountRequest")
 	proto.RegisterType((*DeleteAccountRequest)(nil), "settings.DeleteAccountRequest")
+	proto.RegisterType((*UnlockAccountRequest)(nil), "settings.UnlockAccountRequest")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "settings.ChangePasswordRequest")
+	proto.RegisterType((*ChangeOwnPasswordRequest)(nil), "settings.ChangeOwnPasswordRequest")
+	proto.RegisterType((*EnrollTotpRequest)(nil), "settings.EnrollTotpRequest")
+	proto.RegisterType((*EnrollTotpResponse)(nil), "settings.EnrollTotpResponse")
+	proto.RegisterType((*VerifyTotpRequest)(nil), "settings.VerifyTotpRequest")
+	proto.RegisterType((*VerifyTotpResponse)(nil), "settings.VerifyTotpResponse")
+	proto.RegisterType((*CreateAppPasswordRequest)(nil), "settings.CreateAppPasswordRequest")
+	proto.RegisterType((*CreateAppPasswordResponse)(nil), "settings.CreateAppPasswordResponse")
+	proto.RegisterType((*ListAppPasswordsRequest)(nil), "settings.ListAppPasswordsRequest")
+	proto.RegisterType((*ListAppPasswordsResponse)(nil), "settings.ListAppPasswordsResponse")
+	proto.RegisterType((*RevokeAppPasswordRequest)(nil), "settings.RevokeAppPasswordRequest")
+	proto.RegisterType((*RevokeSessionsRequest)(nil), "settings.RevokeSessionsRequest")
+	proto.RegisterType((*ValidateSessionRequest)(nil), "settings.ValidateSessionRequest")
+	proto.RegisterType((*ValidateSessionResponse)(nil), "settings.ValidateSessionResponse")
+	proto.RegisterType((*RemoveTotpRequest)(nil), "settings.RemoveTotpRequest")
 	proto.RegisterType((*Account)(nil), "settings.Account")
+	proto.RegisterType((*AppPassword)(nil), "settings.AppPassword")
+	proto.RegisterType((*MultiFactorProfile)(nil), "settings.MultiFactorProfile")
 	proto.RegisterType((*Identities)(nil), "settings.Identities")
 	proto.RegisterType((*PasswordProfile)(nil), "settings.PasswordProfile")
 	proto.RegisterType((*ListGroupsRequest)(nil), "settings.ListGroupsRequest")
@@ -1686,6 +4196,12 @@ func init() {
 	proto.RegisterType((*RemoveMemberRequest)(nil), "settings.RemoveMemberRequest")
 	proto.RegisterType((*ListMembersRequest)(nil), "settings.ListMembersRequest")
 	proto.RegisterType((*ListMembersResponse)(nil), "settings.ListMembersResponse")
+	proto.RegisterType((*AddMemberGroupRequest)(nil), "settings.AddMemberGroupRequest")
+	proto.RegisterType((*RemoveMemberGroupRequest)(nil), "settings.RemoveMemberGroupRequest")
+	proto.RegisterType((*ListTransitiveMembersRequest)(nil), "settings.ListTransitiveMembersRequest")
+	proto.RegisterType((*ListTransitiveMembersResponse)(nil), "settings.ListTransitiveMembersResponse")
+	proto.RegisterType((*ListTransitiveMemberOfRequest)(nil), "settings.ListTransitiveMemberOfRequest")
+	proto.RegisterType((*ListTransitiveMemberOfResponse)(nil), "settings.ListTransitiveMemberOfResponse")
 	proto.RegisterType((*Group)(nil), "settings.Group")
 	proto.RegisterType((*OnPremisesProvisioningError)(nil), "settings.OnPremisesProvisioningError")
 }
@@ -1693,129 +4209,268 @@ func init() {
 func init() { proto.RegisterFile("accounts.proto", fileDescriptor_e1e7723af4c007b7) }
 
 var fileDescriptor_e1e7723af4c007b7 = []byte{
-	// 1980 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xdd, 0x6e, 0x1b, 0xc7,
-	0x15, 0x06, 0x45, 0x53, 0x22, 0x0f, 0x25, 0x4b, 0x1a, 0x33, 0xf6, 0x8a, 0xfa, 0x31, 0xbd, 0xb6,
-	0x6c, 0xd9, 0xaa, 0xa5, 0xc0, 0x89, 0xd1, 0xd6, 0x6e, 0x8a, 0xca, 0x92, 0xed, 0x12, 0xf0, 0x8f,
-	0x40, 0x39, 0x2d, 0xda, 0x8b, 0x2c, 0x56, 0xdc, 0x21, 0x35, 0x31, 0xf7, 0x27, 0x33, 0xb3, 0xb2,
-	0x98, 0x20, 0x40, 0xd0, 0xab, 0x5e, 0x16, 0xe8, 0x83, 0x14, 0x7d, 0x83, 0xde, 0xf7, 0xa2, 0x17,
-	0x7d, 0x81, 0x5c, 0xf4, 0xaa, 0x4f, 0x51, 0xcc, 0xdf, 0xee, 0x72, 0x97, 0x14, 0x1d, 0xdb, 0x40,
-	0x11, 0x20, 0x57, 0xe2, 0xce, 0xf9, 0xce, 0xf9, 0xce, 0x9c, 0x39, 0x3b, 0xf3, 0xed, 0x08, 0x2e,
-	0xba, 0xdd, 0x6e, 0x18, 0x07, 0x9c, 0xed, 0x44, 0x34, 0xe4, 0x21, 0xaa, 0x32, 0xcc, 0x39, 0x09,
-	0xfa, 0xac, 0x79, 0xb5, 0x1f, 0x86, 0xfd, 0x01, 0xde, 0x75, 0x23, 0xb2, 0xdb, 0x23, 0x78, 0xe0,
-	0x39, 0xc7, 0xf8, 0xc4, 0x3d, 0x25, 0x21, 0x55, 0xd0, 0xe6, 0x5a, 0x06, 0xe0, 0x06, 0x41, 0xc8,
-	0x5d, 0x4e, 0xc2, 0x40, 0x07, 0x6a, 0xae, 0x6a, 0xab, 0x7c, 0x3a, 0x8e, 0x7b, 0xbb, 0xd8, 0x8f,
-	0xf8, 0x50, 0x1b, 0x5b, 0x79, 0xa3, 0x22, 0xf0, 0x5d, 0xf6, 0x5a, 0x23, 0xae, 0xe6, 0x11, 0x9c,
-	0xf8, 0x98, 0x71, 0xd7, 0x8f, 0x14, 0xc0, 0xfe, 0x7b, 0x09, 0x2e, 0x3d, 0x23, 0x8c, 0xef, 0xe9,
-	0xfc, 0x3b, 0xf8, 0xab, 0x18, 0x33, 0x8e, 0x5a, 0x50, 0x8b, 0xdc, 0x3e, 0x76, 0x18, 0xf9, 0x1a,
-	0x5b, 0xa5, 0x56, 0x69, 0xab, 0xf2, 0xa8, 0xfc, 0xfd, 0x5e, 0xa9, 0x53, 0x15, 0xa3, 0x47, 0xe4,
-	0x6b, 0x8c, 0x6c, 0x00, 0x89, 0xe0, 0xe1, 0x6b, 0x1c, 0x58, 0x33, 0xad, 0xd2, 0x56, 0x4d, 0x41,
-	0xa4, 0xe3, 0x2b, 0x31, 0x8a, 0x7e, 0x09, 0x90, 0xa6, 0x64, 0x95, 0x5b, 0xa5, 0xad, 0xfa, 0xbd,
-	0xe6, 0x8e, 0xca, 0x69, 0xc7, 0xe4, 0xb4, 0xf3, 0x44, 0x40, 0x9e, 0xbb, 0xec, 0x75, 0xa7, 0xd6,
-	0x33, 0x3f, 0xd1, 0x0a, 0x54, 0xbe, 0x8a, 0x31, 0x1d, 0x5a, 0x17, 0xd2, 0xc8, 0x6a, 0xc4, 0xf6,
-	0xa1, 0x31, 0x9a, 0x32, 0x8b, 0xc2, 0x80, 0x61, 0x74, 0x17, 0xaa, 0x66, 0x19, 0xac, 0x52, 0xab,
-	0xbc, 0x55, 0xbf, 0xb7, 0xbc, 0x63, 0xd6, 0x61, 0x47, 0xa3, 0x3b, 0x09, 0x04, 0xdd, 0x84, 0xc5,
-	0x00, 0x9f, 0x71, 0x27, 0x3f, 0x8b, 0xce, 0x82, 0x18, 0x3e, 0x34, 0x93, 0xb0, 0xaf, 0xc3, 0xf2,
-	0x53, 0x6c, 0xd8, 0x4c, 0x7d, 0x2e, 0xc2, 0x0c, 0xf1, 0x64, 0x61, 0x6a, 0x9d, 0x19, 0xe2, 0xd9,
-	0xfb, 0xd0, 0xd8, 0xa7, 0xd8, 0xe5, 0x38, 0x87, 0xdb, 0x86, 0x39, 0x4d, 0x28, 0xc1, 0x63, 0x53,
-	0x32, 0x08, 0xfb, 0xbb, 0x12, 0x34, 0x3e, 0x8f, 0xbc, 0xf7, 0x8b, 0x82, 0x1e, 0x42, 0x3d, 0x96,
-	0x41, 0x54, 0xd5, 0x67, 0xa6, 0x56, 0x1d, 0x14, 0x5c, 0xfc, 0xb6, 0x6f, 0x42, 0xe3, 0x00, 0x0f,
-	0x70, 0x21, 0x83, 0xfc, 0x7c, 0xff, 0xbc, 0x00, 0x73, 0x1a, 0x92, 0xb7, 0xa1, 0x5b, 0xb0, 0xa8,
-	0x73, 0x71, 0x70, 0xe0, 0x1e, 0x0f, 0xb0, 0x27, 0x93, 0xa8, 0x76, 0xcc, 0x5b, 0xf2, 0x58, 0x8d,
-	0xa2, 0x1d, 0xb8, 0x44, 0x98, 0x43, 0x31, 0x0b, 0x63, 0xda, 0xc5, 0x8e, 0x99, 0x62, 0x59, 0x82,
-	0x97, 0x89, 0x58, 0x59, 0x69, 0x31, 0x44, 0xd7, 0x61, 0xa1, 0x2b, 0x8a, 0x4c, 0xc2, 0xc0, 0xe1,
-	0xc3, 0x08, 0xab, 0xde, 0xe8, 0xcc, 0x9b, 0xc1, 0x57, 0xc3, 0x08, 0xa3, 0x4f, 0x01, 0x88, 0x87,
-	0x03, 0x4e, 0x38, 0xc1, 0xcc, 0xaa, 0xc8, 0x3e, 0x68, 0xa4, 0xe5, 0x6a, 0x27, 0xb6, 0x4e, 0x06,
-	0x87, 0xae, 0xc1, 0xbc, 0x47, 0x58, 0x34, 0x70, 0x87, 0x4e, 0xe0, 0xfa, 0xd8, 0x9a, 0x95, 0x91,
-	0xeb, 0x7a, 0xec, 0x85, 0xeb, 0x63, 0xb4, 0x09, 0x17, 0x23, 0x8a, 0x7b, 0x98, 0x52, 0xec, 0x29,
-	0xd0, 0x9c, 0x6a, 0x97, 0x64, 0x54, 0xc2, 0xd6, 0x01, 0x62, 0xe2, 0x39, 0x41, 0xec, 0x1f, 0x63,
-	0x6a, 0x55, 0x5b, 0xa5, 0xad, 0x72, 0xa7, 0x16, 0x13, 0xef, 0x85, 0x1c, 0x10, 0xe6, 0x7e, 0x6a,
-	0xae, 0x29, 0x73, 0x3f, 0x31, 0x23, 0xb8, 0xe0, 0xbb, 0x64, 0x60, 0x81, 0x0c, 0x2d, 0x7f, 0xa3,
-	0x16, 0xd4, 0x3d, 0xcc, 0xba, 0x94, 0x44, 0x62, 0x92, 0x56, 0x5d, 0xa7, 0x96, 0x0e, 0xa1, 0x03,
-	0x58, 0x8a, 0x5c, 0xc6, 0xde, 0x84, 0xd4, 0x73, 0x22, 0x1a, 0xf6, 0xc8, 0x00, 0x5b, 0xf3, 0x72,
-	0xdd, 0x57, 0xd2, 0x99, 0x1f, 0x6a, 0xc4, 0xa1, 0x02, 0x74, 0x16, 0xa3, 0xd1, 0x01, 0xb4, 0x0d,
-	0x55, 0x1f, 0x8b, 0x2c, 0x5e, 0xf6, 0xac, 0x05, 0x59, 0xb7, 0xc5, 0xd4, 0xfb, 0x29, 0x0d, 0xe3,
-	0xa8, 0x93, 0x00, 0xd0, 0x13, 0x58, 0x96, 0x65, 0xc7, 0x9e, 0x23, 0x7b, 0x4d, 0x6c, 0x2c, 0xd6,
-	0xd2, 0x84, 0x5e, 0x7b, 0x65, 0x76, 0x9d, 0xce, 0xa2, 0x76, 0x3a, 0x70, 0x39, 0x16, 0xa3, 0x22,
-	0x8e, 0x27, 0x1b, 0x2e, 0x1b, 0x67, 0x79, 0x7a, 0x1c, 0xed, 0x94, 0xc4, 0xf9, 0x39, 0x58, 0x61,
-	0xe0, 0x44, 0x14, 0xfb, 0x84, 0x61, 0xe6, 0xb0, 0x61, 0xd0, 0x4d, 0xba, 0xaf, 0x21, 0x1b, 0xea,
-	0xa3, 0x30, 0x38, 0xd4, 0xe6, 0xa3, 0x61, 0xd0, 0x35, 0x4d, 0x98, 0x73, 0x24, 0xbe, 0x1f, 0x73,
-	0x61, 0x71, 0x88, 0x67, 0x7d, 0x24, 0x4b, 0x9d, 0x71, 0x6c, 0x1b, 0x6b, 0xdb, 0x43, 0x8f, 0xe1,
-	0xea, 0x08, 0x23, 0xee, 0xc6, 0x94, 0xf0, 0xa1, 0xa3, 0xba, 0xaa, 0x47, 0x30, 0xb5, 0x2e, 0x4b,
-	0xff, 0xb5, 0x0c, 0xb1, 0x06, 0xb5, 0x13, 0x0c, 0xda, 0x87, 0x8d, 0x6c, 0x18, 0x8f, 0x30, 0x51,
-	0xf0, 0x98, 0xb0, 0x13, 0xd3, 0x66, 0x57, 0x64, 0x94, 0xd5, 0x34, 0xca, 0x41, 0x16, 0x23, 0x9b,
-	0xee, 0xd7, 0xb0, 0x36, 0x92, 0x8b, 0xeb, 0x9b, 0xb7, 0x49, 0x85, 0xb0, 0x64, 0x08, 0x2b, 0x93,
-	0x88, 0xeb, 0xeb, 0xb7, 0x4a, 0xfa, 0xdf, 0x87, 0x2b, 0x23, 0x49, 0x84, 0xbe, 0x4b, 0x02, 0xe5,
-	0xba, 0x22, 0x5d, 0x1b, 0x19, 0x76, 0x69, 0x94, 0x6e, 0x07, 0xa3, 0x25, 0x88, 0x19, 0xa6, 0x4e,
-	0x44, 0x49, 0xd0, 0x25, 0x91, 0x3b, 0x50, 0xee, 0xcd, 0x7c, 0xf2, 0x9f, 0x33, 0x4c, 0x0f, 0x0d,
-	0x46, 0x46, 0x71, 0x46, 0xa3, 0x0c, 0x5c, 0xc6, 0xd5, 0xfa, 0xa5, 0x0d, 0xb1, 0x36, 0xb5, 0x21,
-	0x9a, 0x29, 0xc3, 0x33, 0x97, 0x71, 0xb1, 0xc2, 0x49, 0x6f, 0x0c, 0x46, 0x09, 0x22, 0x1a, 0x9e,
-	0x12, 0x46, 0xc2, 0x80, 0x04, 0x7d, 0x07, 0x53, 0x1a, 0x52, 0x66, 0xad, 0xcb, 0x7e, 0xdf, 0x4c,
-	0xfb, 0xfd, 0x65, 0x12, 0xee, 0x30, 0x03, 0x7f, 0x2c, 0xd0, 0xd9, 0x05, 0x2d, 0x18, 0x99, 0xd8,
-	0xd5, 0xf0, 0x19, 0xc7, 0x34, 0x70, 0x07, 0xaa, 0x22, 0x8c, 0xbb, 0x1c, 0x5b, 0x5b, 0xb2, 0x10,
-	0xcb, 0xc6, 0x24, 0xca, 0x70, 0x24, 0x0c, 0x88, 0xc0, 0x8d, 0x31, 0x78, 0xa7, 0x7b, 0xe2, 0x06,
-	0x7d, 0x9c, 0xa9, 0xc1, 0xed, 0xa9, 0x35, 0xb8, 0x5a, 0x08, 0xbe, 0x2f, 0x83, 0x24, 0x85, 0xe8,
-	0xc3, 0x75, 0x8a, 0x7b, 0x14, 0xb3, 0x13, 0x75, 0xe0, 0x31, 0xe7, 0xd4, 0x1d, 0x10, 0xcf, 0xe9,
-	0xd1, 0xd0, 0xcf, 0x30, 0xfd, 0x6a, 0x2a, 0xd3, 0x86, 0x0e, 0x23, 0x4f, 0x48, 0xf6, 0x3b, 0x11,
-	0xe4, 0x09, 0x0d, 0xfd, 0x84, 0xe8, 0x4b, 0xd8, 0x64, 0xa4, 0x1f, 0x38, 0x24, 0x70, 0x18, 0x66,
-	0xa2, 0x3e, 0x13, 0xa8, 0x3e, 0x9b, 0x3e, 0x29, 0x11, 0xa8, 0x1d, 0x1c, 0xe9, 0x30, 0x05, 0x2e,
-	0x9b, 0x03, 0xa4, 0x9b, 0x3a, 0x6a, 0xc1, 0xbc, 0x61, 0x96, 0x47, 0x84, 0x3a, 0x96, 0x40, 0x05,
-	0x91, 0x07, 0xc4, 0x65, 0x98, 0x25, 0x8c, 0xc5, 0x98, 0xea, 0xe3, 0x5e, 0x3f, 0xa1, 0x9f, 0x01,
-	0x52, 0xbf, 0x1c, 0x97, 0x09, 0x38, 0xf6, 0xc4, 0x16, 0x50, 0x96, 0x98, 0x25, 0x65, 0xd9, 0xd3,
-	0x86, 0xb6, 0x67, 0x7f, 0x3f, 0x03, 0x8b, 0xb9, 0x1d, 0x15, 0x35, 0xa1, 0x6a, 0xf6, 0x54, 0xcd,
-	0x9b, 0x3c, 0xa3, 0x2f, 0x60, 0x43, 0x36, 0x76, 0xb2, 0x4f, 0x17, 0xd6, 0x77, 0x66, 0x7a, 0x8f,
-	0x8b, 0x08, 0x86, 0x34, 0xb7, 0xb4, 0xdb, 0xb0, 0x9c, 0x1e, 0x01, 0xe1, 0x80, 0x74, 0xc5, 0xe9,
-	0x57, 0x6e, 0x95, 0x45, 0xf2, 0xc9, 0x46, 0xaf, 0xc7, 0x51, 0x1b, 0xec, 0x5e, 0x28, 0x8e, 0x5c,
-	0x9d, 0x44, 0xe2, 0x29, 0x05, 0x91, 0xae, 0x9f, 0x3c, 0x5d, 0xab, 0x9d, 0x75, 0x89, 0x54, 0x6c,
-	0x86, 0xfb, 0x05, 0x3e, 0xe3, 0x47, 0xb2, 0xa2, 0xe8, 0x0f, 0xb0, 0x3d, 0x3d, 0x94, 0xf3, 0x86,
-	0xf0, 0x13, 0xc7, 0xef, 0xb9, 0x56, 0x45, 0xc6, 0xbc, 0x71, 0x6e, 0xcc, 0xdf, 0x13, 0x7e, 0xf2,
-	0xbc, 0xe7, 0xda, 0x7f, 0x2b, 0xc1, 0xb2, 0x10, 0x7a, 0xf2, 0xe8, 0xf9, 0x51, 0x28, 0x53, 0x0c,
-	0x28, 0x9b, 0xb0, 0xd6, 0xa5, 0xb7, 0x60, 0xb6, 0x2f, 0x47, 0xb4, 0x2a, 0x2d, 0x9c, 0xaa, 0xda,
-	0xfc, 0xd6, 0x8a, 0xf4, 0x1a, 0x2c, 0x3e, 0xc5, 0x8a, 0x65, 0x92, 0x3e, 0x7b, 0x08, 0x48, 0xe9,
-	0xd1, 0x11, 0xd4, 0x26, 0x54, 0x24, 0x95, 0x56, 0x91, 0x85, 0x44, 0x94, 0xd5, 0x3e, 0x03, 0xa4,
-	0x64, 0xe8, 0x3b, 0x38, 0xbf, 0x9f, 0xfc, 0xbc, 0x01, 0x48, 0xc9, 0xcf, 0x73, 0x27, 0xf7, 0x0c,
-	0x96, 0xf6, 0x3c, 0xef, 0xb9, 0x94, 0x22, 0x06, 0xb3, 0x02, 0x55, 0xc9, 0xef, 0x24, 0xc8, 0x39,
-	0xf9, 0xdc, 0xf6, 0x84, 0xe4, 0x32, 0x87, 0x21, 0xf1, 0x74, 0x45, 0x6b, 0x7a, 0xa4, 0xed, 0xd9,
-	0x2f, 0xe1, 0x52, 0x07, 0xfb, 0xe1, 0x29, 0xfe, 0x50, 0x01, 0xff, 0x51, 0x52, 0x6d, 0xa0, 0xe2,
-	0xfd, 0x18, 0x1a, 0x57, 0x57, 0xb8, 0x92, 0x54, 0xf8, 0x4b, 0xf5, 0x55, 0x98, 0xcc, 0x40, 0x77,
-	0xf2, 0x36, 0xcc, 0x29, 0x01, 0x78, 0xce, 0x07, 0x96, 0x41, 0xbc, 0x75, 0x37, 0xff, 0xb7, 0x0a,
-	0x15, 0xb9, 0xdc, 0x85, 0x0f, 0x89, 0xbc, 0x28, 0x9f, 0x29, 0x8a, 0xf2, 0x4c, 0x46, 0xe5, 0xa9,
-	0x19, 0xdd, 0x86, 0xd9, 0xf0, 0x4d, 0x20, 0xb0, 0x17, 0x26, 0x61, 0x35, 0x20, 0xaf, 0xb9, 0x2b,
-	0x45, 0xcd, 0x3d, 0x2a, 0xe4, 0x67, 0xf3, 0x42, 0x7e, 0xac, 0x3e, 0x9e, 0xfb, 0x40, 0xfa, 0xb8,
-	0xfa, 0xc3, 0xf5, 0xf1, 0x33, 0x68, 0xe0, 0xb3, 0x88, 0x50, 0xf5, 0xf5, 0x94, 0x86, 0xaa, 0x4d,
-	0x0d, 0x85, 0x52, 0xbf, 0x24, 0xda, 0x7d, 0xb8, 0x72, 0x42, 0x3c, 0xac, 0x4e, 0x73, 0xd7, 0xf3,
-	0x28, 0x66, 0xcc, 0x19, 0x10, 0xc6, 0x99, 0xfc, 0x72, 0xa9, 0x76, 0x1a, 0xc2, 0x2c, 0x8e, 0xe9,
-	0x3d, 0x65, 0x14, 0xdd, 0xc4, 0xd0, 0x06, 0x80, 0x50, 0x4b, 0xc7, 0x64, 0x40, 0xf8, 0x50, 0x7f,
-	0xc8, 0x64, 0x46, 0x7e, 0x12, 0xf1, 0xff, 0x0f, 0x11, 0xff, 0x0b, 0x58, 0xc9, 0xba, 0x05, 0x98,
-	0x3b, 0xc7, 0x24, 0x64, 0x59, 0xf9, 0x9e, 0x29, 0xde, 0x0b, 0xcc, 0x1f, 0x91, 0x90, 0x49, 0xcf,
-	0xfd, 0xe9, 0xc2, 0x7d, 0x55, 0xfa, 0xbf, 0xa7, 0x38, 0x5f, 0xfb, 0x60, 0xe2, 0xdc, 0xfe, 0x57,
-	0x09, 0x56, 0xcf, 0xf1, 0x16, 0x12, 0xae, 0xeb, 0x72, 0xdc, 0x0f, 0xe9, 0xd0, 0x48, 0x38, 0xf3,
-	0x8c, 0x7e, 0x0b, 0x28, 0xec, 0x76, 0x63, 0xf9, 0xfd, 0xff, 0x43, 0x64, 0xdb, 0x92, 0xf1, 0x4a,
-	0xe6, 0xfc, 0x29, 0x5c, 0x8e, 0x68, 0x18, 0x61, 0xca, 0x87, 0x4e, 0xd7, 0x8d, 0x59, 0x32, 0x57,
-	0x2d, 0x37, 0x1b, 0xc6, 0xba, 0xaf, 0x8c, 0x2a, 0xb7, 0x06, 0x54, 0x4e, 0xdd, 0x41, 0x6c, 0xae,
-	0x3d, 0xd4, 0xc3, 0xbd, 0x7f, 0x5e, 0x80, 0x45, 0x73, 0x15, 0x76, 0x84, 0xe9, 0x29, 0xe9, 0x62,
-	0x74, 0x06, 0xf3, 0xd9, 0x1b, 0x32, 0xb4, 0x9e, 0x96, 0x6e, 0xcc, 0x65, 0x5f, 0x73, 0x63, 0x92,
-	0x59, 0x6d, 0xfb, 0xf6, 0xed, 0x3f, 0xfd, 0xfb, 0x3f, 0x7f, 0x9d, 0xb9, 0x6e, 0x6f, 0xc8, 0x4b,
-	0xca, 0xd3, 0x8f, 0x77, 0xcd, 0x1d, 0x5a, 0xf2, 0xe3, 0xae, 0x78, 0xf7, 0x1f, 0x94, 0xee, 0xa0,
-	0x1e, 0x40, 0x7a, 0x59, 0x86, 0x56, 0x33, 0x1a, 0x21, 0x7f, 0x85, 0xd6, 0x2c, 0xee, 0xbe, 0xf6,
-	0x96, 0x24, 0xb2, 0xed, 0xf5, 0xc9, 0x44, 0x7d, 0x2c, 0x79, 0x42, 0x58, 0x18, 0xb9, 0x6f, 0x43,
-	0x99, 0x39, 0x8c, 0xbb, 0x88, 0x1b, 0xc7, 0xb6, 0x2d, 0xd9, 0x36, 0xed, 0xd6, 0x64, 0x36, 0xb5,
-	0x1b, 0x6b, 0xc2, 0x91, 0xab, 0xb9, 0x2c, 0xe1, 0xb8, 0x3b, 0xbb, 0x77, 0x24, 0x54, 0x5a, 0x48,
-	0x10, 0x72, 0x58, 0x18, 0xb9, 0x89, 0xcb, 0x12, 0x8e, 0xbb, 0xa2, 0x6b, 0x5e, 0x2e, 0xb4, 0xe0,
-	0x63, 0x3f, 0xe2, 0xc3, 0xb7, 0x61, 0x55, 0x87, 0xc5, 0x83, 0xd2, 0x9d, 0x7b, 0x7f, 0x99, 0x83,
-	0x05, 0x25, 0x5f, 0x4d, 0x2f, 0x45, 0x00, 0xa9, 0xa6, 0xcd, 0xae, 0x68, 0x41, 0x9a, 0x37, 0xd7,
-	0xc6, 0x1b, 0x75, 0x17, 0xdd, 0x92, 0x79, 0x5c, 0xb3, 0xd7, 0x0a, 0x79, 0x28, 0xf9, 0x9b, 0xf4,
-	0xd0, 0x17, 0x50, 0x35, 0xf2, 0x16, 0xad, 0x8c, 0x74, 0x50, 0x56, 0x15, 0x36, 0xf3, 0x02, 0xd4,
-	0xbe, 0x29, 0x09, 0x5a, 0xf6, 0xea, 0x24, 0x02, 0xdd, 0x3b, 0x7d, 0xa8, 0x67, 0xb4, 0x31, 0x5a,
-	0xcb, 0x77, 0xce, 0xf9, 0x2c, 0x93, 0x5f, 0x06, 0xcd, 0x92, 0xf6, 0x4c, 0x1f, 0xea, 0x19, 0x1d,
-	0x9d, 0x25, 0x2a, 0xca, 0xeb, 0x77, 0x20, 0x4a, 0x7b, 0x25, 0x80, 0x7a, 0x46, 0x36, 0x67, 0x89,
-	0x8a, 0x6a, 0x7a, 0x62, 0x9f, 0x4c, 0xe5, 0x4b, 0xba, 0x04, 0xf9, 0x50, 0x4b, 0x04, 0x38, 0x6a,
-	0x66, 0x1a, 0x3d, 0xa7, 0xca, 0x8b, 0x93, 0xfa, 0x44, 0x92, 0xdc, 0xb5, 0xb7, 0x0c, 0x89, 0x8a,
-	0xbd, 0xfb, 0x8d, 0xd1, 0xda, 0x9f, 0xdd, 0xf9, 0x76, 0x57, 0xeb, 0xb5, 0xdd, 0x1b, 0x14, 0xf7,
-	0x04, 0xdd, 0x77, 0x25, 0x98, 0xcf, 0x4a, 0xf4, 0xec, 0x7e, 0x36, 0x46, 0xba, 0x17, 0x59, 0x7f,
-	0x23, 0x59, 0x1f, 0xd8, 0xf7, 0xdf, 0x86, 0xf5, 0x9b, 0x54, 0xdb, 0x7f, 0x9b, 0xa4, 0x30, 0x84,
-	0x7a, 0x46, 0x10, 0xa3, 0x5c, 0xa7, 0x8f, 0x2a, 0xfd, 0xe6, 0xfa, 0x04, 0xab, 0x7e, 0x11, 0xee,
-	0xca, 0x6c, 0x6e, 0xd9, 0x76, 0x3e, 0x9b, 0xb1, 0xb3, 0x7f, 0xd4, 0xf8, 0x23, 0x8a, 0x5e, 0xf7,
-	0xd5, 0xbf, 0x70, 0x76, 0x4f, 0x3f, 0x7e, 0xa8, 0x96, 0x6d, 0x56, 0xfe, 0xf9, 0xe4, 0x7f, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0x52, 0x6c, 0xdb, 0xd1, 0x7a, 0x1a, 0x00, 0x00,
+	// 4193 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3b, 0xcb, 0x72, 0x1b, 0x49,
+	0x72, 0xdb, 0x20, 0x41, 0x02, 0x09, 0x3e, 0xc0, 0x22, 0x48, 0x41, 0x10, 0x29, 0x51, 0xad, 0x17,
+	0x45, 0x2d, 0xc9, 0x59, 0x8d, 0xe4, 0xd5, 0xcc, 0x78, 0x67, 0x87, 0x22, 0x41, 0x09, 0x31, 0x14,
+	0xc0, 0x6d, 0x90, 0x9a, 0xdd, 0x0d, 0x7b, 0x3a, 0x9a, 0x40, 0x01, 0xec, 0x15, 0xd0, 0xdd, 0xd3,
+	0xd5, 0xa0, 0x84, 0x19, 0x4f, 0x78, 0xc3, 0xeb, 0x83, 0x1f, 0xe1, 0x3d, 0xd8, 0xb1, 0xf6, 0x65,
+	0xe7, 0xee, 0x83, 0x7d, 0xf4, 0xd9, 0x17, 0x5f, 0x6d, 0x1f, 0xfc, 0x07, 0xf6, 0x7e, 0x80, 0xc3,
+	0xe1, 0xf0, 0xd9, 0x51, 0x8f, 0x6e, 0x54, 0x3f, 0xf0, 0x90, 0x46, 0x1e, 0xc7, 0x46, 0xcc, 0x89,
+	0xa8, 0xca, 0xac, 0xcc, 0xac, 0x7c, 0x54, 0x65, 0x65, 0x27, 0x61, 0xc1, 0x68, 0x34, 0xec, 0x9e,
+	0xe5, 0x91, 0x1d, 0xc7, 0xb5, 0x3d, 0x1b, 0x65, 0x08, 0xf6, 0x3c, 0xd3, 0x6a, 0x93, 0xd2, 0xb5,
+	0xb6, 0x6d, 0xb7, 0x3b, 0x78, 0xd7, 0x70, 0xcc, 0xdd, 0x96, 0x89, 0x3b, 0x4d, 0xfd, 0x0c, 0x9f,
+	0x1b, 0x17, 0xa6, 0xed, 0x72, 0xd4, 0xd2, 0x9a, 0x84, 0x60, 0x58, 0x96, 0xed, 0x19, 0x9e, 0x69,
+	0x5b, 0x82, 0x50, 0xe9, 0x8a, 0x80, 0xb2, 0xd1, 0x59, 0xaf, 0xb5, 0x8b, 0xbb, 0x8e, 0xd7, 0x17,
+	0xc0, 0x8d, 0x28, 0x90, 0x33, 0xe8, 0x1a, 0xe4, 0x85, 0xc0, 0xb8, 0x16, 0xc5, 0xf0, 0xcc, 0x2e,
+	0x26, 0x9e, 0xd1, 0x75, 0x38, 0x82, 0xfa, 0xdf, 0x29, 0x58, 0x3e, 0x32, 0x89, 0xb7, 0x27, 0xe4,
+	0xd7, 0xf0, 0x67, 0x3d, 0x4c, 0x3c, 0x74, 0x1d, 0xb2, 0x8e, 0xd1, 0xc6, 0x3a, 0x31, 0x3f, 0xc7,
+	0x45, 0x65, 0x43, 0xd9, 0x4c, 0x3f, 0x9e, 0xfe, 0xf7, 0x3d, 0x45, 0xd1, 0x32, 0x74, 0xba, 0x6e,
+	0x7e, 0x8e, 0xd1, 0x0d, 0x00, 0x86, 0xe2, 0xd9, 0x2f, 0xb0, 0x55, 0x4c, 0x6d, 0x28, 0x9b, 0x59,
+	0x81, 0xc3, 0x96, 0x9e, 0xd0, 0x69, 0xf4, 0x1e, 0xc0, 0x40, 0xa8, 0xe2, 0xd4, 0x86, 0xb2, 0x99,
+	0xbb, 0x5f, 0xda, 0xe1, 0x52, 0xed, 0xf8, 0x52, 0xed, 0x1c, 0x52, 0x94, 0x67, 0x06, 0x79, 0xa1,
+	0x65, 0x5b, 0xfe, 0x4f, 0x54, 0x82, 0xf4, 0x67, 0x3d, 0xec, 0xf6, 0x8b, 0xd3, 0x12, 0x69, 0x3e,
+	0x85, 0xae, 0x41, 0xc6, 0x76, 0x9b, 0xd8, 0xd5, 0xcf, 0xfa, 0xc5, 0xb4, 0x04, 0x9e, 0x65, 0xb3,
+	0x8f, 0xfb, 0xe8, 0x3e, 0x20, 0xd3, 0x6a, 0x74, 0x7a, 0x4d, 0x2a, 0x9f, 0x67, 0x74, 0xf8, 0x46,
+	0x66, 0x36, 0x94, 0xcd, 0x8c, 0x40, 0xcd, 0x0b, 0xf8, 0x09, 0x05, 0xb3, 0x0d, 0xad, 0xc1, 0x4c,
+	0xcb, 0x68, 0x60, 0x8f, 0x14, 0x67, 0x37, 0xa6, 0x02, 0x92, 0x62, 0x8e, 0x42, 0x09, 0x36, 0xdc,
+	0xc6, 0x79, 0x31, 0x23, 0x31, 0x14, 0x73, 0x68, 0x1b, 0x16, 0x5b, 0x66, 0xc7, 0xc3, 0xae, 0xde,
+	0x31, 0xac, 0x76, 0xcf, 0x68, 0xe3, 0x62, 0x56, 0x42, 0x5b, 0xe0, 0xc0, 0x23, 0x01, 0x53, 0xff,
+	0x41, 0x81, 0x42, 0x58, 0xed, 0xc4, 0xb1, 0x2d, 0x82, 0xd1, 0x36, 0x64, 0x7c, 0x57, 0x2a, 0x2a,
+	0x1b, 0x53, 0x9b, 0xb9, 0xfb, 0x4b, 0x3b, 0xbe, 0x2f, 0xed, 0x08, 0x6c, 0x2d, 0x40, 0x41, 0xb7,
+	0x61, 0xd1, 0xc2, 0xaf, 0x3c, 0x3d, 0x6a, 0x08, 0x6d, 0x9e, 0x4e, 0x1f, 0x07, 0x66, 0x58, 0x07,
+	0x90, 0xd4, 0x40, 0xcd, 0x90, 0xd6, 0xb2, 0x5e, 0xb0, 0xf3, 0x3b, 0xc1, 0xce, 0xa7, 0x19, 0xcf,
+	0xc5, 0x01, 0xcf, 0x43, 0x3a, 0xef, 0x2b, 0x41, 0xfd, 0xa5, 0x02, 0x69, 0x36, 0x83, 0x0a, 0x90,
+	0x66, 0xa6, 0x62, 0xce, 0x91, 0xd5, 0xf8, 0x80, 0xce, 0x32, 0xaa, 0x4c, 0x8a, 0xb4, 0xc6, 0x07,
+	0xa8, 0x08, 0xb3, 0x5d, 0x93, 0x10, 0xd3, 0x6a, 0x0b, 0xd6, 0xfe, 0x90, 0xe2, 0xdb, 0xde, 0x39,
+	0x76, 0x99, 0x8d, 0xd3, 0x1a, 0x1f, 0xa0, 0xbb, 0x90, 0xf6, 0xb0, 0xdb, 0x25, 0xc5, 0x34, 0x93,
+	0x66, 0x39, 0x22, 0xcd, 0x09, 0x76, 0xbb, 0x1a, 0xc7, 0x50, 0x1f, 0x42, 0x36, 0x98, 0x43, 0x08,
+	0xa6, 0xe9, 0xac, 0x10, 0x89, 0xfd, 0xa6, 0x1c, 0x98, 0xae, 0x7c, 0x89, 0xd8, 0x40, 0xfd, 0x31,
+	0x5c, 0xaa, 0x33, 0xc3, 0x1d, 0xbb, 0xa6, 0xd5, 0x30, 0x1d, 0xa3, 0x13, 0x78, 0x7e, 0xe0, 0x76,
+	0x4a, 0x60, 0xbf, 0x94, 0xef, 0x76, 0xa1, 0xa8, 0x48, 0x25, 0x45, 0x85, 0x5a, 0x83, 0x62, 0x9c,
+	0xb2, 0x30, 0xee, 0xbb, 0x00, 0x4e, 0x30, 0x5b, 0x54, 0xa2, 0x9b, 0x0b, 0x56, 0x68, 0x12, 0x9a,
+	0xfa, 0x1f, 0x0a, 0x64, 0x03, 0x08, 0x5a, 0x80, 0x94, 0xe9, 0xeb, 0x3c, 0x65, 0x36, 0xd9, 0x96,
+	0xfb, 0x0e, 0x16, 0x56, 0x67, 0xbf, 0xd1, 0x75, 0x98, 0x6b, 0x9a, 0xc4, 0xe9, 0x18, 0x7d, 0xdd,
+	0x32, 0xba, 0xdc, 0xdc, 0x59, 0x2d, 0x27, 0xe6, 0xaa, 0x46, 0x17, 0xa3, 0x5b, 0xb0, 0xe0, 0xb8,
+	0xb8, 0x85, 0x5d, 0x17, 0x37, 0x39, 0xd2, 0x34, 0x77, 0x9b, 0x60, 0x96, 0xa1, 0x7d, 0x08, 0x6b,
+	0xb6, 0xa5, 0x3b, 0x2e, 0xee, 0x9a, 0x04, 0x13, 0x9d, 0x18, 0x5d, 0x5d, 0xb8, 0x1e, 0x5f, 0xc4,
+	0x42, 0x4f, 0x2b, 0xda, 0xd6, 0xb1, 0x40, 0xa9, 0x1b, 0x5d, 0xe1, 0xa4, 0x6c, 0x3d, 0x82, 0xe9,
+	0xae, 0x61, 0x76, 0x58, 0xdc, 0x65, 0x35, 0xf6, 0x9b, 0x1a, 0x84, 0x34, 0x6c, 0x17, 0x17, 0x67,
+	0x37, 0x94, 0x4d, 0x45, 0xe3, 0x03, 0xf5, 0x0b, 0x28, 0x3c, 0x37, 0x3a, 0x66, 0xd3, 0xf0, 0xf0,
+	0x8f, 0xa8, 0xaa, 0x27, 0xb1, 0x46, 0x42, 0xcc, 0xa5, 0x86, 0xc7, 0x1c, 0x2a, 0x0a, 0x55, 0x4d,
+	0x49, 0x38, 0x6c, 0x46, 0xfd, 0x09, 0xac, 0x44, 0x98, 0x0b, 0x83, 0x15, 0x20, 0x7d, 0x41, 0x01,
+	0x8c, 0x7b, 0x46, 0xe3, 0x03, 0xb4, 0x05, 0x69, 0xec, 0xba, 0xb6, 0xcb, 0xb8, 0xe5, 0xee, 0x17,
+	0x06, 0x16, 0x64, 0xab, 0xcb, 0x14, 0xa6, 0x71, 0x14, 0xf5, 0x4f, 0x15, 0x80, 0xc1, 0x2c, 0x8b,
+	0x04, 0x4c, 0x08, 0x15, 0x95, 0xdb, 0xd0, 0x1f, 0xf2, 0xc8, 0x19, 0xc4, 0x2f, 0x1f, 0xa0, 0x12,
+	0x64, 0x1c, 0x9b, 0x98, 0xf4, 0x46, 0x10, 0xa1, 0x13, 0x8c, 0xd1, 0x2e, 0x2c, 0x93, 0x9e, 0xe3,
+	0xd8, 0xae, 0x87, 0x9b, 0xba, 0xed, 0x60, 0xd7, 0xf0, 0x6c, 0x97, 0x47, 0x70, 0x56, 0x43, 0x01,
+	0xa8, 0xe6, 0x43, 0xd4, 0xaf, 0x14, 0x58, 0x2e, 0xbf, 0x72, 0x3a, 0x86, 0x69, 0x7d, 0xe3, 0x3a,
+	0x0e, 0x87, 0xce, 0x74, 0x62, 0xe8, 0xfc, 0x93, 0x02, 0x85, 0xb0, 0x7c, 0xc2, 0x0c, 0xeb, 0xf4,
+	0xa6, 0x71, 0x09, 0xd6, 0x3d, 0x17, 0xfb, 0x8a, 0xcb, 0xb2, 0x99, 0x13, 0x17, 0x63, 0x74, 0x0d,
+	0x72, 0x67, 0x1d, 0x7c, 0x81, 0x75, 0xbe, 0x0b, 0xae, 0x40, 0x60, 0x53, 0x8c, 0x0e, 0xfa, 0x08,
+	0x16, 0x0d, 0xcb, 0xe8, 0xf4, 0x3f, 0xc7, 0x4d, 0xfd, 0xc2, 0xe8, 0xf4, 0x30, 0x29, 0x4e, 0xb1,
+	0xe0, 0xbb, 0x24, 0x9d, 0xad, 0x02, 0xe1, 0x39, 0x85, 0x6b, 0x0b, 0x86, 0x3c, 0x24, 0x68, 0x0b,
+	0xa6, 0xcf, 0xcd, 0xe0, 0x78, 0x5c, 0x1d, 0x2c, 0x13, 0xf2, 0xe2, 0xe6, 0x53, 0xd3, 0xd3, 0x18,
+	0x8e, 0x6a, 0xc3, 0x7c, 0x88, 0xd8, 0xf0, 0xa3, 0x92, 0xc9, 0xe2, 0x1b, 0x9c, 0x0d, 0xa8, 0xc1,
+	0x05, 0x6b, 0x57, 0xc4, 0x6d, 0x30, 0x46, 0xab, 0x30, 0xc3, 0xbc, 0xc2, 0xb7, 0xb1, 0x18, 0xa9,
+	0xcf, 0x61, 0x4e, 0x16, 0x23, 0x76, 0x46, 0x04, 0x11, 0x97, 0x92, 0x22, 0x0e, 0x6d, 0x40, 0x0e,
+	0xd3, 0x55, 0x96, 0x11, 0x78, 0x57, 0x56, 0x93, 0xa7, 0xd4, 0x3f, 0x84, 0xd2, 0x5e, 0xcf, 0x3b,
+	0xc7, 0x96, 0x67, 0x36, 0x0c, 0x0f, 0xfb, 0xb7, 0x8f, 0xf0, 0x9a, 0x02, 0xa4, 0x3b, 0x76, 0xdb,
+	0xb4, 0xfc, 0x5d, 0xb1, 0x01, 0x73, 0x58, 0x83, 0x90, 0x97, 0xb6, 0xdb, 0x14, 0x1b, 0x0b, 0xc6,
+	0xe8, 0x32, 0x64, 0xba, 0x2d, 0x43, 0x6f, 0xd8, 0x4d, 0xff, 0x4c, 0x9a, 0xed, 0xb6, 0x8c, 0x7d,
+	0xbb, 0x89, 0x85, 0x88, 0x8e, 0x7f, 0x0c, 0xf1, 0x81, 0xfa, 0x8f, 0x0a, 0x5c, 0x49, 0x94, 0x40,
+	0xf8, 0xc5, 0x7b, 0x30, 0xdb, 0x32, 0xcc, 0x4e, 0xcf, 0xe5, 0x4e, 0xb1, 0x70, 0xff, 0x9a, 0x64,
+	0xcf, 0xc1, 0x3a, 0xd3, 0xb6, 0x0e, 0x39, 0x9a, 0xe6, 0xe3, 0xa3, 0x7b, 0x30, 0x2b, 0x4e, 0x32,
+	0x11, 0xc5, 0x09, 0xd7, 0xac, 0x8f, 0x81, 0x1e, 0xc1, 0x9c, 0xe1, 0x38, 0x7a, 0xb0, 0x31, 0x9e,
+	0xc6, 0xac, 0x48, 0x2b, 0x1c, 0xe7, 0x58, 0x00, 0xb5, 0x9c, 0x31, 0x18, 0xa8, 0x9f, 0xc2, 0xd2,
+	0x13, 0xec, 0x45, 0x34, 0x17, 0xb5, 0x4f, 0x38, 0x47, 0x4a, 0xbd, 0x46, 0x8e, 0xa4, 0xee, 0x43,
+	0x61, 0xdf, 0xc5, 0x71, 0xe3, 0x48, 0xdb, 0x53, 0xc6, 0x6d, 0x4f, 0xfd, 0xb9, 0x02, 0x85, 0x53,
+	0xa7, 0xf9, 0xf5, 0xa8, 0xa0, 0x0f, 0x20, 0xd7, 0x63, 0x44, 0x26, 0xdd, 0x06, 0x70, 0x74, 0xb6,
+	0x8f, 0xdb, 0x50, 0x38, 0xc0, 0x1d, 0xec, 0xe1, 0xd1, 0xaa, 0xa2, 0x78, 0xa7, 0x56, 0xc7, 0x6e,
+	0xbc, 0x18, 0x83, 0xf7, 0xd7, 0x0a, 0xac, 0xec, 0x9f, 0x1b, 0x56, 0x1b, 0x07, 0x76, 0x19, 0xe9,
+	0xb6, 0x77, 0x21, 0xdf, 0xe8, 0xb9, 0x2e, 0xb6, 0x3c, 0x3d, 0xe2, 0xbe, 0x8b, 0x62, 0xde, 0xa7,
+	0x43, 0x6f, 0x57, 0x0b, 0xbf, 0x0c, 0x3b, 0x43, 0x56, 0xcb, 0x59, 0xf8, 0xe5, 0x71, 0x92, 0xa3,
+	0x4f, 0x87, 0x1c, 0x5d, 0xfd, 0x85, 0x02, 0x45, 0x2e, 0x58, 0xed, 0xa5, 0x15, 0x95, 0x2d, 0x49,
+	0x0a, 0x65, 0x32, 0x29, 0x52, 0xa3, 0xa5, 0x08, 0x87, 0x9b, 0x5a, 0x85, 0xa5, 0xb2, 0xe5, 0xda,
+	0x9d, 0xce, 0x89, 0xed, 0x39, 0x3e, 0xf7, 0x75, 0x00, 0xff, 0x72, 0x0f, 0x74, 0x99, 0x15, 0x33,
+	0x95, 0xe6, 0xa8, 0xc8, 0x56, 0xcb, 0x80, 0x64, 0x7a, 0x22, 0x3c, 0x57, 0x69, 0xc6, 0xdc, 0x70,
+	0xb1, 0x27, 0x88, 0x89, 0x11, 0xba, 0x04, 0xb3, 0x2f, 0x70, 0x5f, 0xef, 0xb9, 0xa6, 0x20, 0x34,
+	0xf3, 0x02, 0xf7, 0x4f, 0x5d, 0x53, 0x3d, 0x84, 0xa5, 0xe7, 0xd8, 0x35, 0x5b, 0xfd, 0xd7, 0x10,
+	0x0b, 0xc1, 0x34, 0xdb, 0xa1, 0x48, 0x80, 0xe8, 0x6f, 0xf5, 0x03, 0x40, 0x32, 0x1d, 0x21, 0xce,
+	0x2d, 0x58, 0x70, 0x71, 0xc3, 0xbe, 0xc0, 0x6e, 0x9f, 0x29, 0x85, 0x67, 0x60, 0x59, 0x6d, 0xde,
+	0x9f, 0xa5, 0xaa, 0x21, 0xea, 0xaf, 0xa9, 0x85, 0x78, 0x4c, 0x49, 0x51, 0x3d, 0xb1, 0x30, 0x2c,
+	0x2f, 0x12, 0xc2, 0xd0, 0xdf, 0x4c, 0x0b, 0xf4, 0x34, 0xe3, 0x77, 0x4e, 0x56, 0x13, 0xa3, 0x90,
+	0x3e, 0xa7, 0x47, 0x9c, 0x94, 0xe9, 0xb0, 0xe9, 0x3e, 0x83, 0xcb, 0x09, 0xd2, 0x89, 0x2d, 0x46,
+	0x0f, 0x2a, 0x65, 0xd2, 0x83, 0x6a, 0xa4, 0x75, 0x1f, 0xc1, 0x25, 0xf6, 0x56, 0x19, 0xa0, 0x93,
+	0xc9, 0xf4, 0xa1, 0x3e, 0x87, 0x62, 0x7c, 0xa5, 0x90, 0xf5, 0x7d, 0x98, 0x97, 0x65, 0xf5, 0xf3,
+	0xe1, 0x21, 0xc2, 0xce, 0x49, 0xc2, 0x12, 0xb5, 0x02, 0x45, 0x0d, 0x5f, 0xd8, 0x2f, 0xde, 0xc0,
+	0x44, 0xfc, 0xa4, 0x48, 0x05, 0x27, 0xc5, 0xef, 0xc0, 0x0a, 0x27, 0x55, 0xc7, 0x84, 0xd0, 0x87,
+	0xf7, 0x84, 0x5b, 0xfb, 0x5b, 0x05, 0x56, 0xfd, 0xa4, 0x51, 0x2c, 0x9d, 0x50, 0x82, 0x03, 0xc8,
+	0x9b, 0x84, 0xf4, 0x70, 0x53, 0x67, 0xa7, 0x25, 0x7d, 0x91, 0x0f, 0x3d, 0x2d, 0x4f, 0xfc, 0xe7,
+	0xba, 0xb6, 0xc0, 0xd7, 0x1c, 0x18, 0x1e, 0xa6, 0x93, 0xe8, 0xae, 0x94, 0x69, 0x2d, 0xc8, 0x5a,
+	0x13, 0xc2, 0x9c, 0xf4, 0x1d, 0x2c, 0xd2, 0xdb, 0x5d, 0xb8, 0x14, 0x93, 0x74, 0x54, 0x82, 0xab,
+	0x9e, 0xc1, 0x92, 0x86, 0xbb, 0xf6, 0x05, 0xfe, 0x7a, 0x71, 0x18, 0x72, 0xaa, 0xa9, 0x88, 0x53,
+	0xfd, 0x7a, 0x11, 0x66, 0xc5, 0x21, 0x1e, 0xbb, 0x10, 0xef, 0xc0, 0xa2, 0xcf, 0x0a, 0x5b, 0xc6,
+	0x59, 0x07, 0x73, 0x83, 0x65, 0x34, 0xbf, 0xcc, 0x52, 0xe6, 0xb3, 0x68, 0x07, 0x96, 0x4d, 0xa2,
+	0xbb, 0x98, 0xd8, 0x3d, 0xb7, 0x81, 0xfd, 0xb7, 0x09, 0xe3, 0x95, 0xd1, 0x96, 0x4c, 0xa2, 0x09,
+	0x88, 0xcf, 0xe8, 0x06, 0xcc, 0x37, 0x68, 0xf0, 0x98, 0xb6, 0xa5, 0x33, 0xed, 0xf1, 0xc0, 0x9b,
+	0xf3, 0x27, 0xa9, 0xd2, 0xd0, 0x03, 0x00, 0xb3, 0x49, 0x53, 0x07, 0xcf, 0xc4, 0xfe, 0x13, 0x54,
+	0xca, 0xf1, 0x2b, 0x01, 0x4c, 0x93, 0xf0, 0x62, 0x8f, 0xae, 0x99, 0x49, 0x1e, 0x5d, 0xb3, 0x49,
+	0x8f, 0xae, 0x75, 0x80, 0x9e, 0xd9, 0xd4, 0xad, 0x5e, 0xf7, 0x0c, 0xbb, 0xac, 0xd8, 0x30, 0xa5,
+	0x65, 0x7b, 0x66, 0xb3, 0xca, 0x26, 0x28, 0xb8, 0x3d, 0x00, 0x67, 0x39, 0xb8, 0x1d, 0x80, 0xfd,
+	0x27, 0x17, 0x48, 0x4f, 0xae, 0x0d, 0xc8, 0x35, 0x31, 0x69, 0xb8, 0xa6, 0xc3, 0x52, 0xbd, 0x9c,
+	0x10, 0x6d, 0x30, 0x45, 0x7d, 0xd2, 0xb7, 0x8c, 0xee, 0xb8, 0x76, 0xcb, 0xec, 0xe0, 0xe2, 0x1c,
+	0xf3, 0xc9, 0xcb, 0xd2, 0xfb, 0x54, 0x60, 0x1c, 0x73, 0x04, 0x6d, 0xd1, 0x09, 0x4f, 0xa0, 0x7b,
+	0x90, 0xe9, 0x62, 0x2a, 0x45, 0xad, 0x55, 0x9c, 0x8f, 0x16, 0x12, 0x9e, 0xb8, 0x76, 0xcf, 0xd1,
+	0x02, 0x04, 0x74, 0x08, 0x4b, 0x4c, 0xed, 0xa1, 0x38, 0xc8, 0x8f, 0x8d, 0x83, 0x45, 0xb1, 0x28,
+	0x08, 0x84, 0x43, 0x58, 0x6a, 0xb2, 0xd4, 0x41, 0xa6, 0xb3, 0x34, 0x9e, 0x8e, 0x58, 0x14, 0xd0,
+	0xf9, 0x3e, 0x14, 0x43, 0x6f, 0xdd, 0xbe, 0xd5, 0x08, 0xbc, 0xaf, 0xc0, 0x1c, 0x6a, 0x45, 0x7a,
+	0xe7, 0xf6, 0xad, 0x86, 0xef, 0x84, 0x91, 0x85, 0x66, 0xb7, 0xdb, 0xf3, 0x28, 0x84, 0x86, 0xc9,
+	0x0a, 0x53, 0xb5, 0xb4, 0xb0, 0xe2, 0x43, 0x2b, 0x4d, 0x54, 0x86, 0x6b, 0x21, 0x8e, 0xb8, 0xd1,
+	0x73, 0x4d, 0xaf, 0xaf, 0x73, 0xaf, 0x6a, 0x99, 0xd8, 0x2d, 0xae, 0xb2, 0xf5, 0x6b, 0x12, 0x63,
+	0x81, 0x54, 0x09, 0x70, 0xd0, 0x3e, 0x5c, 0x95, 0xc9, 0x34, 0x4d, 0x42, 0x15, 0xde, 0x33, 0xc9,
+	0xb9, 0xef, 0x66, 0x97, 0x18, 0x95, 0x2b, 0x03, 0x2a, 0x07, 0x32, 0xce, 0x44, 0x2f, 0xfd, 0xe2,
+	0x98, 0x97, 0xfe, 0x43, 0xb8, 0x14, 0x12, 0xc2, 0xee, 0x1a, 0xa6, 0xc5, 0x97, 0x5e, 0x66, 0x4b,
+	0x0b, 0x12, 0x77, 0x06, 0x64, 0xcb, 0x0e, 0xc2, 0x2a, 0xe8, 0x11, 0xec, 0xea, 0x41, 0xed, 0x83,
+	0x2f, 0x2f, 0x45, 0x85, 0x3f, 0x25, 0xd8, 0x0d, 0x0a, 0x22, 0x8c, 0x8a, 0x1e, 0xa6, 0xd2, 0x31,
+	0x88, 0xc7, 0xed, 0x37, 0x70, 0x88, 0xb5, 0xb1, 0x0e, 0x51, 0x1a, 0x70, 0x38, 0x32, 0x88, 0x47,
+	0x2d, 0x1c, 0xf8, 0x46, 0x27, 0xcc, 0xc0, 0x71, 0xed, 0x0b, 0x93, 0x9e, 0xa3, 0xa6, 0xd5, 0xd6,
+	0xd9, 0x3b, 0x9f, 0x14, 0xd7, 0x99, 0xbf, 0xdf, 0x1a, 0xf8, 0x7b, 0x2d, 0x20, 0x77, 0x2c, 0xa1,
+	0xf3, 0xe2, 0xc0, 0x9a, 0x3d, 0x1c, 0x48, 0xe8, 0xa9, 0x86, 0x5f, 0x79, 0xd8, 0xb5, 0x8c, 0x0e,
+	0xd7, 0x08, 0xf1, 0x0c, 0x0f, 0x17, 0x37, 0x99, 0x22, 0x96, 0x7c, 0x10, 0x55, 0x43, 0x9d, 0x02,
+	0x90, 0x09, 0x37, 0x13, 0xf0, 0xf5, 0x06, 0x4b, 0x33, 0x25, 0x1d, 0xdc, 0x1d, 0xab, 0x83, 0x6b,
+	0x31, 0xe2, 0x3c, 0x57, 0x0d, 0x14, 0xd1, 0x86, 0x1b, 0x2e, 0x6e, 0xb9, 0x98, 0x9c, 0xf3, 0x6a,
+	0x23, 0xd1, 0xd9, 0x8d, 0xa1, 0xb7, 0x5c, 0xbb, 0x2b, 0x71, 0xfa, 0xdd, 0xb1, 0x9c, 0xae, 0x0a,
+	0x32, 0xac, 0x3c, 0x49, 0xd8, 0xf5, 0x74, 0xe8, 0xda, 0xdd, 0x80, 0xd1, 0xcf, 0xe0, 0x16, 0x31,
+	0xdb, 0x96, 0x6e, 0x5a, 0x3a, 0x11, 0x17, 0x73, 0x32, 0xab, 0x1f, 0x8c, 0xdf, 0x14, 0x25, 0x54,
+	0xb1, 0xfc, 0xfb, 0x3d, 0xce, 0xab, 0x06, 0xab, 0xf4, 0x49, 0x81, 0x9b, 0x7a, 0xcf, 0xf2, 0xcc,
+	0x8e, 0x44, 0xfc, 0xc3, 0xb1, 0xc4, 0x97, 0xf9, 0xca, 0x53, 0xba, 0x30, 0x20, 0xf8, 0x10, 0x2e,
+	0xd1, 0x77, 0x26, 0x6e, 0xea, 0xfe, 0x1e, 0x0c, 0xcf, 0xa3, 0x85, 0x7b, 0x52, 0xfc, 0x21, 0x2b,
+	0xe2, 0x14, 0x38, 0xb8, 0xce, 0x04, 0xdb, 0x13, 0x30, 0x54, 0x85, 0x42, 0xb7, 0xd7, 0xf1, 0x4c,
+	0xbd, 0x65, 0x34, 0x3c, 0xdb, 0x0d, 0x0e, 0xe2, 0x8f, 0x98, 0x14, 0x6b, 0x03, 0xd7, 0x7a, 0x46,
+	0xb1, 0x0e, 0x19, 0x92, 0x7f, 0x16, 0xa3, 0x6e, 0x6c, 0x2e, 0x9e, 0x61, 0xed, 0x4d, 0x9e, 0x61,
+	0xfd, 0xa7, 0x02, 0x39, 0x09, 0x9a, 0x54, 0x77, 0x9c, 0x38, 0xd3, 0x4d, 0x3c, 0xe9, 0xa7, 0x5f,
+	0xff, 0xa4, 0xaf, 0xc0, 0x32, 0x0b, 0xed, 0x1e, 0x09, 0x51, 0x4a, 0x8f, 0xa5, 0x94, 0xa7, 0xcb,
+	0x4e, 0x89, 0x44, 0x0a, 0xc1, 0xf4, 0xb9, 0x41, 0xce, 0xfd, 0xc2, 0x24, 0xfd, 0xad, 0xfe, 0x4b,
+	0x0a, 0x50, 0x5c, 0xb3, 0xf4, 0x62, 0xf7, 0x6c, 0xcf, 0x09, 0xee, 0x02, 0x9e, 0x29, 0xe5, 0xe8,
+	0x9c, 0x7f, 0x03, 0xd4, 0x60, 0x55, 0x46, 0x79, 0xad, 0xbc, 0x6e, 0x59, 0x22, 0x14, 0x88, 0xf7,
+	0x08, 0x8a, 0x2e, 0xa6, 0x87, 0x24, 0x3d, 0x60, 0x22, 0x8f, 0x16, 0x5e, 0x06, 0x5c, 0x0d, 0xe0,
+	0x9a, 0xfc, 0x7a, 0x41, 0xf7, 0x61, 0x05, 0x5b, 0x0d, 0xb7, 0xef, 0x50, 0x6d, 0x33, 0xa1, 0xc4,
+	0x13, 0x8c, 0x67, 0x3a, 0xcb, 0x01, 0x90, 0xa6, 0x76, 0x75, 0x06, 0x42, 0x37, 0x61, 0x81, 0xe9,
+	0x95, 0xa3, 0x7b, 0xd8, 0x61, 0x2a, 0x9d, 0xd2, 0xe6, 0xe8, 0x2c, 0xc3, 0xf3, 0xb0, 0x83, 0xde,
+	0x81, 0x42, 0x48, 0x12, 0x9d, 0x2a, 0x0d, 0x93, 0xe2, 0x0c, 0xaf, 0x37, 0xca, 0x8f, 0xa8, 0xa7,
+	0x0c, 0xa2, 0x7a, 0x00, 0x83, 0x64, 0x09, 0x6d, 0xc0, 0x9c, 0x1f, 0x0d, 0x2c, 0xf5, 0xe2, 0xbe,
+	0x04, 0x3c, 0x38, 0x59, 0xe2, 0xb5, 0x0a, 0x33, 0x2c, 0xc9, 0x75, 0xfd, 0x67, 0x21, 0x1f, 0xa1,
+	0xef, 0x02, 0xe2, 0xbf, 0x74, 0x83, 0x50, 0x74, 0xdc, 0xa4, 0x57, 0x2b, 0x4f, 0x28, 0x79, 0x2a,
+	0xed, 0xee, 0x09, 0x40, 0xa5, 0xa9, 0xfe, 0xf9, 0x14, 0x2c, 0x46, 0x32, 0x95, 0x50, 0x22, 0xaa,
+	0x44, 0xde, 0x5a, 0x9f, 0xc2, 0x55, 0xb6, 0x7b, 0x7f, 0x22, 0x7e, 0x6e, 0x8e, 0x37, 0x62, 0x89,
+	0x52, 0xf0, 0x99, 0x46, 0x8e, 0xcc, 0x7b, 0xb0, 0x14, 0x90, 0x76, 0xec, 0x8e, 0xd9, 0x30, 0x83,
+	0x00, 0x09, 0x72, 0xae, 0x63, 0x31, 0x8f, 0x2a, 0xa0, 0xb6, 0x6c, 0x9a, 0xca, 0x0a, 0x21, 0x82,
+	0x95, 0xec, 0x2b, 0x8f, 0xd0, 0x1f, 0xb3, 0x65, 0x46, 0x5b, 0x67, 0x98, 0xe1, 0x2a, 0x47, 0x15,
+	0xbf, 0xf2, 0xf8, 0xa9, 0x82, 0x7e, 0x02, 0xf7, 0xc6, 0x93, 0xd2, 0x5f, 0x9a, 0xde, 0xb9, 0xde,
+	0x6d, 0x19, 0xcc, 0xe4, 0x19, 0xed, 0xe6, 0x48, 0x9a, 0x9f, 0x98, 0xde, 0xf9, 0xb3, 0x96, 0x41,
+	0xeb, 0x14, 0x01, 0xb5, 0x73, 0x93, 0x78, 0xb6, 0xdb, 0x17, 0x6e, 0x10, 0xa4, 0x84, 0x4f, 0xf9,
+	0xb4, 0xfa, 0x5f, 0x29, 0x58, 0xa2, 0x4f, 0x40, 0x96, 0xfd, 0x7d, 0xfb, 0x75, 0xf1, 0x9b, 0xf9,
+	0xba, 0xf8, 0xf7, 0x0a, 0x20, 0x59, 0xe9, 0xe2, 0xb1, 0x77, 0x07, 0x66, 0xda, 0x6c, 0xa6, 0xa8,
+	0x24, 0x27, 0xe7, 0x02, 0xfc, 0x8d, 0x7f, 0x55, 0xfc, 0x3d, 0x58, 0x7c, 0x82, 0xb9, 0xb4, 0xff,
+	0x07, 0x35, 0xd2, 0x0f, 0x00, 0xf1, 0x8a, 0x49, 0x88, 0xc1, 0x2d, 0x48, 0xb3, 0xdd, 0x8a, 0x1a,
+	0x49, 0x4c, 0x17, 0x1c, 0xaa, 0xbe, 0x02, 0xc4, 0x4b, 0xa3, 0x6f, 0xb0, 0xf8, 0xeb, 0x95, 0x44,
+	0x6f, 0x02, 0xe2, 0x25, 0xd1, 0x51, 0x7a, 0x51, 0x8f, 0x20, 0xbf, 0xd7, 0x6c, 0x3e, 0x63, 0x8f,
+	0x2a, 0x1f, 0xe7, 0x32, 0x64, 0x18, 0xff, 0xc1, 0x3b, 0x7d, 0x96, 0x8d, 0x2b, 0xcd, 0xc8, 0x23,
+	0x3e, 0x15, 0x2d, 0x6a, 0xd4, 0x60, 0x99, 0x3f, 0xfc, 0xdf, 0x16, 0xc1, 0xdf, 0x08, 0x4f, 0xe4,
+	0xf4, 0x7e, 0x5b, 0xe2, 0x9f, 0x2b, 0x39, 0x1d, 0x38, 0x9f, 0x7c, 0x1e, 0xcc, 0x24, 0x9c, 0x07,
+	0xea, 0xcf, 0x60, 0x39, 0xb4, 0x4b, 0x11, 0x70, 0xf7, 0xe8, 0xd7, 0x3e, 0x36, 0x35, 0xfc, 0x5b,
+	0xbe, 0x8f, 0x31, 0x69, 0xd0, 0xa9, 0x3f, 0x85, 0x95, 0xc0, 0xe2, 0x21, 0xd7, 0x18, 0x61, 0xa5,
+	0xdb, 0xb0, 0xc8, 0xd9, 0xe8, 0x01, 0x86, 0xa0, 0xdd, 0x1d, 0xd0, 0xa9, 0x34, 0xd5, 0xdf, 0x87,
+	0xa2, 0x6c, 0xff, 0xb7, 0x4d, 0xde, 0x84, 0x35, 0xaa, 0xa6, 0x13, 0xd7, 0xb0, 0x88, 0xe9, 0x99,
+	0x17, 0x38, 0xe2, 0x16, 0x6f, 0x31, 0xe8, 0x8f, 0x60, 0x7d, 0x08, 0xab, 0x37, 0xb0, 0x8d, 0xfa,
+	0x61, 0x32, 0xb5, 0x5a, 0x6b, 0xc2, 0x62, 0x61, 0x05, 0xae, 0x0e, 0x5b, 0xff, 0x9a, 0x67, 0xb3,
+	0xfa, 0xcf, 0x59, 0x48, 0xb3, 0x99, 0x98, 0xb6, 0xa2, 0x15, 0xa8, 0x54, 0xbc, 0x02, 0x25, 0x6d,
+	0x7a, 0x6a, 0xac, 0x43, 0xde, 0x85, 0x19, 0xfb, 0xa5, 0x85, 0x5d, 0xff, 0xf8, 0x4e, 0xc0, 0x15,
+	0x08, 0xd1, 0x02, 0x53, 0x3a, 0x5e, 0x60, 0x0a, 0x57, 0xad, 0x66, 0xa2, 0x55, 0xab, 0xc4, 0x27,
+	0xc2, 0xec, 0x5b, 0x2a, 0x06, 0x65, 0x5e, 0xbf, 0x18, 0x74, 0x04, 0x05, 0xfc, 0xca, 0x31, 0x5d,
+	0x5e, 0x2a, 0x1c, 0x90, 0xca, 0x8e, 0x25, 0x85, 0x06, 0xeb, 0xe4, 0xf7, 0xe0, 0xb9, 0xd9, 0xc4,
+	0xfc, 0xe9, 0x6a, 0x34, 0x9b, 0x2e, 0x26, 0x44, 0xef, 0x98, 0xc4, 0x23, 0xac, 0x4c, 0x97, 0xd1,
+	0x0a, 0x14, 0x4c, 0xdf, 0xa4, 0x7b, 0x1c, 0x48, 0x9d, 0x85, 0xa0, 0xab, 0x00, 0xb4, 0x34, 0x70,
+	0x66, 0x76, 0x4c, 0xaf, 0x2f, 0xaa, 0x76, 0xd2, 0xcc, 0xb7, 0x15, 0xab, 0xff, 0x8f, 0x8a, 0xd5,
+	0x23, 0xb8, 0x2c, 0x2f, 0xb3, 0xb0, 0xa7, 0x9f, 0x99, 0x36, 0x91, 0x6b, 0x55, 0x92, 0xf2, 0xaa,
+	0xd8, 0x7b, 0x6c, 0xda, 0x84, 0xad, 0xdc, 0x1f, 0x5f, 0xa5, 0xba, 0xc2, 0xd6, 0x7f, 0xcd, 0x4a,
+	0xd4, 0xda, 0xdb, 0xab, 0x44, 0x3d, 0x80, 0x79, 0xf9, 0x60, 0xf7, 0xab, 0x5c, 0xb1, 0xc3, 0x69,
+	0x4e, 0x3a, 0xe7, 0x49, 0xa8, 0x0c, 0x7c, 0x75, 0x4c, 0x19, 0x58, 0xfd, 0x57, 0x05, 0xae, 0x8c,
+	0x10, 0x90, 0x3e, 0xdd, 0x1a, 0x86, 0x87, 0xdb, 0xb6, 0xdf, 0x9f, 0xa2, 0x05, 0x63, 0xf4, 0x14,
+	0x90, 0xdd, 0x60, 0x5f, 0x46, 0x5f, 0xef, 0xcd, 0x9d, 0xf7, 0x57, 0x05, 0x6a, 0x7d, 0x00, 0xab,
+	0x8e, 0x6b, 0x3b, 0xd8, 0xf5, 0xfa, 0x7a, 0xc3, 0xe8, 0x91, 0x40, 0x9d, 0xe2, 0x99, 0x59, 0xf0,
+	0xa1, 0xfb, 0x1c, 0xc8, 0x65, 0x0b, 0x5a, 0x38, 0xa6, 0xa5, 0x16, 0x8e, 0xad, 0x5f, 0xa4, 0x60,
+	0x25, 0xb1, 0xfb, 0x00, 0x2d, 0x00, 0x54, 0x6b, 0xfa, 0xe1, 0x5e, 0xe5, 0xe8, 0x54, 0x2b, 0xe7,
+	0xbf, 0x83, 0x96, 0x61, 0xf1, 0xb4, 0xfa, 0x71, 0xb5, 0xf6, 0x49, 0x55, 0xdf, 0xdb, 0xdf, 0xaf,
+	0x9d, 0x56, 0x4f, 0xf2, 0x0a, 0x2a, 0x40, 0xbe, 0x52, 0x7d, 0xbe, 0x77, 0x54, 0x39, 0xd0, 0x8f,
+	0xf7, 0xea, 0xf5, 0x4f, 0x6a, 0xda, 0x41, 0x3e, 0x45, 0x67, 0x05, 0x8a, 0x7e, 0x50, 0xa9, 0xef,
+	0x3d, 0x3e, 0x2a, 0x1f, 0xe4, 0xa7, 0x10, 0x82, 0x05, 0x7f, 0xf6, 0xa8, 0xb6, 0xff, 0x71, 0xf9,
+	0x20, 0x3f, 0x4d, 0x31, 0xfd, 0x75, 0x7a, 0xf9, 0xc7, 0xc7, 0x15, 0xad, 0x7c, 0x90, 0x4f, 0xa3,
+	0x25, 0x98, 0xaf, 0xd7, 0x4e, 0xb5, 0xfd, 0xb2, 0x8f, 0x38, 0x83, 0xd6, 0xa0, 0x18, 0x20, 0xee,
+	0x3f, 0xdd, 0xab, 0x3e, 0x29, 0xeb, 0x5a, 0xf9, 0x47, 0xa7, 0x6c, 0xc1, 0x2c, 0xca, 0xc3, 0xdc,
+	0xb3, 0xc3, 0xbd, 0xc1, 0x4c, 0x46, 0x16, 0x8c, 0x42, 0xf6, 0x6b, 0x07, 0xe5, 0x7c, 0x16, 0xad,
+	0x02, 0xaa, 0x54, 0xeb, 0xa7, 0x87, 0x87, 0x95, 0xfd, 0x4a, 0xb9, 0x7a, 0xa2, 0xd7, 0xf7, 0x6b,
+	0xc7, 0xe5, 0x3c, 0x6c, 0x3d, 0x84, 0x9c, 0xf4, 0x25, 0x8a, 0x6e, 0xb5, 0x5e, 0x79, 0x52, 0xd5,
+	0x2b, 0x55, 0xbd, 0x5e, 0xae, 0xd7, 0x2b, 0xb5, 0x6a, 0xfe, 0x3b, 0x54, 0x28, 0xad, 0x7c, 0xa8,
+	0x95, 0xeb, 0x4f, 0xf5, 0x93, 0xda, 0xc7, 0xe5, 0x6a, 0x5e, 0xb9, 0xff, 0x55, 0x01, 0x16, 0x45,
+	0x94, 0x92, 0x3a, 0x76, 0x2f, 0xcc, 0x06, 0x46, 0xaf, 0x60, 0x4e, 0xee, 0x95, 0x44, 0xeb, 0x03,
+	0x6f, 0x4a, 0x68, 0x5d, 0x2d, 0x5d, 0x1d, 0x06, 0xe6, 0x57, 0xad, 0x7a, 0xf7, 0x8f, 0xfe, 0xed,
+	0x37, 0x7f, 0x95, 0xba, 0xa1, 0x5e, 0x65, 0x2d, 0xb7, 0x17, 0xef, 0xec, 0xfa, 0xdd, 0x94, 0xc1,
+	0x8f, 0x6d, 0x7a, 0x36, 0xbf, 0xaf, 0x6c, 0xa1, 0x16, 0xc0, 0xa0, 0x7d, 0x03, 0x5d, 0x91, 0xbc,
+	0x38, 0xda, 0xd4, 0x51, 0x8a, 0xdf, 0x8e, 0xea, 0x26, 0x63, 0xa4, 0xaa, 0xeb, 0xc3, 0x19, 0xb5,
+	0x31, 0xe3, 0x63, 0xc3, 0x7c, 0xa8, 0x8d, 0x03, 0x49, 0x7b, 0x48, 0xea, 0xef, 0x48, 0xe2, 0x76,
+	0x8f, 0x71, 0xbb, 0xf5, 0xbe, 0xb2, 0xa5, 0x6e, 0x0c, 0x67, 0xc8, 0x2f, 0x4c, 0xca, 0x30, 0xd4,
+	0xf1, 0x21, 0x33, 0x4c, 0x6a, 0x05, 0x19, 0xc1, 0x70, 0x14, 0x37, 0xfe, 0x9c, 0xa1, 0x3b, 0xf4,
+	0x60, 0x3e, 0xd4, 0xe0, 0x21, 0x33, 0x4c, 0xea, 0xfc, 0x28, 0xad, 0xc6, 0xe2, 0xb7, 0x4c, 0x3b,
+	0x9f, 0x27, 0xe1, 0xca, 0x2f, 0x73, 0xca, 0xf5, 0x4f, 0x14, 0xc8, 0x47, 0xbb, 0x31, 0xd1, 0x75,
+	0xf9, 0x5b, 0x69, 0x62, 0x0f, 0x68, 0x49, 0x1d, 0x85, 0x22, 0xdc, 0x68, 0x9b, 0x09, 0x72, 0x47,
+	0x55, 0x63, 0x82, 0x0c, 0x9a, 0x37, 0xb7, 0xf9, 0xfb, 0x9d, 0x8a, 0xf2, 0x07, 0x30, 0x1f, 0xea,
+	0x31, 0x94, 0x15, 0x90, 0xd4, 0xf9, 0x58, 0xba, 0x36, 0x14, 0x2e, 0x04, 0xd8, 0x62, 0x02, 0xdc,
+	0xa4, 0x06, 0xbf, 0x16, 0x93, 0x81, 0x3d, 0x64, 0xb6, 0x2f, 0xc4, 0x42, 0xf4, 0x2a, 0x68, 0x11,
+	0xe3, 0xcc, 0xd7, 0x63, 0x1d, 0x6c, 0x21, 0xde, 0x57, 0x87, 0x81, 0xc7, 0x86, 0x10, 0xe7, 0x8b,
+	0xf9, 0x22, 0xba, 0xef, 0x5f, 0x29, 0xb0, 0x9c, 0xd0, 0xc3, 0x85, 0x6e, 0x26, 0xb6, 0x6a, 0x45,
+	0xbd, 0xe0, 0xd6, 0x18, 0x2c, 0x21, 0xcf, 0xf7, 0x98, 0x3c, 0xf7, 0xd4, 0xdb, 0xc3, 0x9d, 0xc2,
+	0x90, 0x96, 0x8b, 0x90, 0x0b, 0x75, 0x12, 0x85, 0x22, 0x20, 0xa1, 0xc5, 0xe8, 0xcd, 0x43, 0xae,
+	0xc7, 0xa8, 0xa1, 0x5f, 0x2a, 0xb0, 0x10, 0x2e, 0xac, 0x21, 0xc9, 0xc4, 0x89, 0xcd, 0x4a, 0x93,
+	0x6e, 0xff, 0x01, 0x93, 0x63, 0x47, 0xbd, 0x3b, 0x22, 0xee, 0x19, 0xfd, 0x6d, 0xbf, 0x3c, 0x47,
+	0x35, 0xf0, 0xc7, 0x0a, 0x2c, 0xc5, 0x5a, 0x91, 0x90, 0x1a, 0x95, 0x29, 0xde, 0xa7, 0x94, 0xa4,
+	0x8a, 0x47, 0x4c, 0x84, 0xfb, 0xea, 0xf6, 0x58, 0x11, 0xec, 0x97, 0x56, 0x48, 0x8c, 0x3e, 0xc0,
+	0xa0, 0x77, 0x48, 0x3e, 0x63, 0x63, 0x1d, 0x4a, 0xa5, 0xb5, 0x64, 0xa0, 0xd0, 0xc2, 0x3b, 0x4c,
+	0x84, 0x2d, 0x6a, 0x8d, 0x5b, 0xc3, 0xa5, 0xf0, 0x6c, 0xcf, 0xd9, 0xc6, 0x6c, 0x35, 0x65, 0x3d,
+	0xe8, 0x13, 0x92, 0x59, 0xc7, 0xba, 0x90, 0x4a, 0x6b, 0xc9, 0xc0, 0x30, 0xeb, 0xb1, 0x7c, 0x2f,
+	0xd8, 0x52, 0xba, 0x6b, 0x0b, 0x60, 0xd0, 0x62, 0x21, 0xb3, 0x8e, 0x35, 0x5e, 0x24, 0x69, 0x7b,
+	0x52, 0x7e, 0x2e, 0x23, 0x46, 0xf9, 0xfd, 0x0d, 0x35, 0x76, 0xb4, 0x6f, 0x28, 0x64, 0xec, 0x21,
+	0x2d, 0x4f, 0xa5, 0x1b, 0x23, 0x71, 0x84, 0x02, 0xde, 0x63, 0x02, 0xbd, 0x4b, 0x75, 0xbf, 0x33,
+	0x22, 0x06, 0x1d, 0x27, 0x30, 0x7d, 0x70, 0x15, 0xfd, 0xa5, 0x02, 0xf9, 0x68, 0x93, 0x90, 0x7c,
+	0x46, 0x0f, 0x69, 0x3d, 0x2a, 0xa9, 0xa3, 0x50, 0x84, 0x58, 0xdf, 0x67, 0x62, 0x7d, 0x4f, 0xfd,
+	0xee, 0xa4, 0x32, 0xf9, 0x17, 0xff, 0x9f, 0x29, 0xb0, 0xc4, 0xdb, 0x82, 0x86, 0xa8, 0x6b, 0x58,
+	0xfb, 0xd1, 0xd0, 0x7b, 0xeb, 0x4d, 0x34, 0xe4, 0x32, 0x26, 0xe8, 0x73, 0x58, 0x08, 0xb7, 0x28,
+	0xc9, 0x07, 0x47, 0x62, 0xf3, 0x52, 0x92, 0xcf, 0x4c, 0x70, 0x48, 0x70, 0x7e, 0xdb, 0xfe, 0x07,
+	0x57, 0xaa, 0x88, 0xbf, 0x50, 0x60, 0x31, 0xd2, 0x3c, 0x84, 0x36, 0xe2, 0x37, 0x53, 0xb8, 0x03,
+	0xaa, 0x74, 0x7d, 0x04, 0x86, 0x30, 0xcd, 0x43, 0x26, 0xce, 0x2e, 0xd5, 0xc7, 0xd6, 0x70, 0x89,
+	0xfc, 0x0b, 0xcc, 0x97, 0xe9, 0xfe, 0xff, 0xe4, 0x60, 0x9e, 0x3f, 0x33, 0xfc, 0xec, 0xd0, 0x01,
+	0x18, 0xd4, 0xba, 0xe5, 0x48, 0x8a, 0x7d, 0x76, 0x28, 0xad, 0x25, 0x03, 0x85, 0x44, 0x77, 0x98,
+	0x44, 0xd7, 0xd5, 0xb5, 0x98, 0x38, 0xfc, 0xf1, 0x13, 0x38, 0xc7, 0xa7, 0x90, 0xf1, 0xcb, 0xd5,
+	0xe8, 0x72, 0x28, 0x27, 0x94, 0x0b, 0x66, 0xa5, 0xe8, 0xa3, 0x47, 0xbd, 0xcd, 0x18, 0x6c, 0xa8,
+	0x57, 0x86, 0x31, 0x10, 0xd9, 0x60, 0x1b, 0x72, 0x52, 0xc1, 0x1a, 0xad, 0x45, 0x03, 0x70, 0x34,
+	0x97, 0xe1, 0x77, 0xb3, 0xe0, 0xc2, 0xe3, 0x4e, 0x30, 0x92, 0x8a, 0xdb, 0x32, 0xa3, 0x78, 0xcd,
+	0xfb, 0x0d, 0x18, 0x0d, 0xb2, 0x3f, 0x0b, 0x72, 0x52, 0x2d, 0x5b, 0x66, 0x14, 0x2f, 0x71, 0x0f,
+	0x8d, 0x20, 0xc1, 0x8f, 0x7a, 0xcc, 0x50, 0x96, 0x3c, 0xf5, 0x43, 0x5d, 0xc8, 0x06, 0x35, 0x52,
+	0x54, 0x92, 0x62, 0x21, 0x52, 0x2a, 0x8f, 0x6f, 0xea, 0x5d, 0xc6, 0x64, 0x9b, 0x32, 0xd9, 0xf4,
+	0x99, 0x70, 0xda, 0xbb, 0x5f, 0xf8, 0xc5, 0xcd, 0x1f, 0x6c, 0x7d, 0xb9, 0x2b, 0x8a, 0x64, 0xbb,
+	0x37, 0x5d, 0xdc, 0x42, 0x3f, 0x57, 0x60, 0x4e, 0xae, 0x9b, 0xca, 0xe9, 0x55, 0x42, 0x3d, 0x3d,
+	0xce, 0xf5, 0x23, 0xc6, 0xf5, 0x7d, 0xf5, 0xe1, 0x24, 0x2c, 0xbf, 0x18, 0x54, 0x1a, 0xbf, 0x64,
+	0xfc, 0xf9, 0x2d, 0x9a, 0x93, 0x2a, 0xd0, 0x28, 0xe2, 0xe9, 0xe1, 0x3a, 0x6b, 0x69, 0x7d, 0x08,
+	0x34, 0x9c, 0xd9, 0x52, 0x1d, 0xa8, 0x51, 0x81, 0x12, 0x76, 0xff, 0x25, 0x2c, 0x84, 0x0b, 0xd2,
+	0xf2, 0xf1, 0x94, 0x58, 0xaa, 0x8e, 0x2b, 0x40, 0x9c, 0x8e, 0xea, 0x4e, 0x94, 0x5f, 0x5c, 0x01,
+	0xdb, 0x02, 0xe4, 0xef, 0xfc, 0x57, 0x8a, 0xdf, 0xad, 0x28, 0x8b, 0xa0, 0x26, 0x5b, 0x60, 0xb4,
+	0x14, 0x1f, 0x33, 0x29, 0xca, 0xea, 0x47, 0x93, 0x4b, 0xf1, 0x45, 0xa4, 0xf0, 0x3d, 0xb0, 0xc8,
+	0x57, 0x0a, 0xac, 0x24, 0x96, 0xa0, 0xd1, 0xed, 0xb0, 0xfa, 0x87, 0x95, 0xc3, 0x4b, 0x77, 0xc6,
+	0xe2, 0x09, 0x83, 0x8d, 0x72, 0x5a, 0x2e, 0xb4, 0x17, 0xac, 0xdd, 0xf6, 0xcb, 0xbb, 0x7f, 0xa7,
+	0xc0, 0x6a, 0x72, 0x51, 0x1a, 0x8d, 0x61, 0x1c, 0x94, 0xbd, 0x4b, 0x9b, 0xe3, 0x11, 0x85, 0x88,
+	0x3f, 0x64, 0x22, 0xbe, 0xa7, 0x3e, 0x88, 0x45, 0xae, 0xe4, 0xce, 0x89, 0x92, 0x6e, 0xdb, 0x54,
+	0x9d, 0x8f, 0x0b, 0x3f, 0x45, 0xce, 0x8b, 0x36, 0xff, 0x47, 0xd6, 0xdd, 0x8b, 0x77, 0x3e, 0x60,
+	0x3f, 0xce, 0x66, 0xd8, 0x9f, 0x77, 0xff, 0x77, 0x00, 0x3c, 0x40, 0xb8, 0x53, 0x80, 0x3b, 0x00,
+	0x00,
 }
@@ -76,6 +76,111 @@ func NewAccountsServiceEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "AccountsService.SearchPrincipals",
+			Path:    []string{"/api/v0/accounts/principals-search"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ValidateQuery",
+			Path:    []string{"/api/v0/accounts/query-validate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ExplainQuery",
+			Path:    []string{"/api/v0/accounts/query-explain"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.AuthenticateAccount",
+			Path:    []string{"/api/v0/accounts/accounts-authenticate"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.UnlockAccount",
+			Path:    []string{"/api/v0/accounts/accounts-unlock"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ChangePassword",
+			Path:    []string{"/api/v0/accounts/accounts-change-password"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ChangeOwnPassword",
+			Path:    []string{"/api/v0/accounts/accounts-change-own-password"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.EnrollTotp",
+			Path:    []string{"/api/v0/accounts/accounts-totp-enroll"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.VerifyTotp",
+			Path:    []string{"/api/v0/accounts/accounts-totp-verify"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RemoveTotp",
+			Path:    []string{"/api/v0/accounts/accounts-totp-remove"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.CreateAppPassword",
+			Path:    []string{"/api/v0/accounts/accounts-app-passwords-create"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ListAppPasswords",
+			Path:    []string{"/api/v0/accounts/accounts-app-passwords-list"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RevokeAppPassword",
+			Path:    []string{"/api/v0/accounts/accounts-app-passwords-revoke"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.RevokeSessions",
+			Path:    []string{"/api/v0/accounts/accounts-revoke-sessions"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "AccountsService.ValidateSession",
+			Path:    []string{"/api/v0/accounts/accounts-validate-session"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -92,6 +197,36 @@ type AccountsService interface {
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...client.CallOption) (*Account, error)
 	// Deletes an account
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...client.CallOption) (*empty.Empty, error)
+	// Searches accounts and groups by prefix, eg. for sharing dialogs
+	SearchPrincipals(ctx context.Context, in *SearchPrincipalsRequest, opts ...client.CallOption) (*SearchPrincipalsResponse, error)
+	// Checks if a query can be used to list accounts or groups
+	ValidateQuery(ctx context.Context, in *ValidateQueryRequest, opts ...client.CallOption) (*ValidateQueryResponse, error)
+	// Explains how a query is parsed, analyzed and scored
+	ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...client.CallOption) (*ExplainQueryResponse, error)
+	// Authenticates an account by its login and password
+	AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error)
+	// Unlocks an account that was locked after too many failed sign-in attempts
+	UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...client.CallOption) (*Account, error)
+	// Changes the password of an account by its login and current password
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error)
+	// Changes the password of the account that makes the request
+	ChangeOwnPassword(ctx context.Context, in *ChangeOwnPasswordRequest, opts ...client.CallOption) (*Account, error)
+	// Starts the enrollment of a TOTP secret for multi-factor authentication
+	EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error)
+	// Confirms the enrolled TOTP secret with a code and returns new recovery codes
+	VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error)
+	// Removes the TOTP secret and the recovery codes of an account
+	RemoveTotp(ctx context.Context, in *RemoveTotpRequest, opts ...client.CallOption) (*Account, error)
+	// Creates an application password for clients that cannot use the password of the account
+	CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...client.CallOption) (*CreateAppPasswordResponse, error)
+	// Lists the application passwords of an account
+	ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...client.CallOption) (*ListAppPasswordsResponse, error)
+	// Revokes an application password
+	RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...client.CallOption) (*empty.Empty, error)
+	// Invalidates all sessions and refresh tokens of an account issued before now
+	RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error)
+	// Checks whether a session or refresh token issued at a given time is still valid for an account
+	ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error)
 }
 
 type accountsService struct {
@@ -156,6 +291,156 @@ func (c *accountsService) DeleteAccount(ctx context.Context, in *DeleteAccountRe
 	return out, nil
 }
 
+func (c *accountsService) SearchPrincipals(ctx context.Context, in *SearchPrincipalsRequest, opts ...client.CallOption) (*SearchPrincipalsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.SearchPrincipals", in)
+	out := new(SearchPrincipalsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ValidateQuery(ctx context.Context, in *ValidateQueryRequest, opts ...client.CallOption) (*ValidateQueryResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ValidateQuery", in)
+	out := new(ValidateQueryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, opts ...client.CallOption) (*ExplainQueryResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ExplainQuery", in)
+	out := new(ExplainQueryResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.AuthenticateAccount", in)
+	out := new(AuthenticateAccountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.UnlockAccount", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...client.CallOption) (*AuthenticateAccountResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ChangePassword", in)
+	out := new(AuthenticateAccountResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ChangeOwnPassword(ctx context.Context, in *ChangeOwnPasswordRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ChangeOwnPassword", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, opts ...client.CallOption) (*EnrollTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.EnrollTotp", in)
+	out := new(EnrollTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, opts ...client.CallOption) (*VerifyTotpResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.VerifyTotp", in)
+	out := new(VerifyTotpResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RemoveTotp(ctx context.Context, in *RemoveTotpRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RemoveTotp", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, opts ...client.CallOption) (*CreateAppPasswordResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.CreateAppPassword", in)
+	out := new(CreateAppPasswordResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, opts ...client.CallOption) (*ListAppPasswordsResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ListAppPasswords", in)
+	out := new(ListAppPasswordsResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, opts ...client.CallOption) (*empty.Empty, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RevokeAppPassword", in)
+	out := new(empty.Empty)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, opts ...client.CallOption) (*Account, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.RevokeSessions", in)
+	out := new(Account)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountsService) ValidateSession(ctx context.Context, in *ValidateSessionRequest, opts ...client.CallOption) (*ValidateSessionResponse, error) {
+	req := c.c.NewRequest(c.name, "AccountsService.ValidateSession", in)
+	out := new(ValidateSessionResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for AccountsService service
 
 type AccountsServiceHandler interface {
@@ -169,6 +454,36 @@ type AccountsServiceHandler interface {
 	UpdateAccount(context.Context, *UpdateAccountRequest, *Account) error
 	// Deletes an account
 	DeleteAccount(context.Context, *DeleteAccountRequest, *empty.Empty) error
+	// Searches accounts and groups by prefix, eg. for sharing dialogs
+	SearchPrincipals(context.Context, *SearchPrincipalsRequest, *SearchPrincipalsResponse) error
+	// Checks if a query can be used to list accounts or groups
+	ValidateQuery(context.Context, *ValidateQueryRequest, *ValidateQueryResponse) error
+	// Explains how a query is parsed, analyzed and scored
+	ExplainQuery(context.Context, *ExplainQueryRequest, *ExplainQueryResponse) error
+	// Authenticates an account by its login and password
+	AuthenticateAccount(context.Context, *AuthenticateAccountRequest, *AuthenticateAccountResponse) error
+	// Unlocks an account that was locked after too many failed sign-in attempts
+	UnlockAccount(context.Context, *UnlockAccountRequest, *Account) error
+	// Changes the password of an account by its login and current password
+	ChangePassword(context.Context, *ChangePasswordRequest, *AuthenticateAccountResponse) error
+	// Changes the password of the account that makes the request
+	ChangeOwnPassword(context.Context, *ChangeOwnPasswordRequest, *Account) error
+	// Starts the enrollment of a TOTP secret for multi-factor authentication
+	EnrollTotp(context.Context, *EnrollTotpRequest, *EnrollTotpResponse) error
+	// Confirms the enrolled TOTP secret with a code and returns new recovery codes
+	VerifyTotp(context.Context, *VerifyTotpRequest, *VerifyTotpResponse) error
+	// Removes the TOTP secret and the recovery codes of an account
+	RemoveTotp(context.Context, *RemoveTotpRequest, *Account) error
+	// Creates an application password for clients that cannot use the password of the account
+	CreateAppPassword(context.Context, *CreateAppPasswordRequest, *CreateAppPasswordResponse) error
+	// Lists the application passwords of an account
+	ListAppPasswords(context.Context, *ListAppPasswordsRequest, *ListAppPasswordsResponse) error
+	// Revokes an application password
+	RevokeAppPassword(context.Context, *RevokeAppPasswordRequest, *empty.Empty) error
+	// Invalidates all sessions and refresh tokens of an account issued before now
+	RevokeSessions(context.Context, *RevokeSessionsRequest, *Account) error
+	// Checks whether a session or refresh token issued at a given time is still valid for an account
+	ValidateSession(context.Context, *ValidateSessionRequest, *ValidateSessionResponse) error
 }
 
 func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler, opts ...server.HandlerOption) error {
@@ -178,6 +493,21 @@ func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler
 		CreateAccount(ctx context.Context, in *CreateAccountRequest, out *Account) error
 		UpdateAccount(ctx context.Context, in *UpdateAccountRequest, out *Account) error
 		DeleteAccount(ctx context.Context, in *DeleteAccountRequest, out *empty.Empty) error
+		SearchPrincipals(ctx context.Context, in *SearchPrincipalsRequest, out *SearchPrincipalsResponse) error
+		ValidateQuery(ctx context.Context, in *ValidateQueryRequest, out *ValidateQueryResponse) error
+		ExplainQuery(ctx context.Context, in *ExplainQueryRequest, out *ExplainQueryResponse) error
+		AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest, out *AuthenticateAccountResponse) error
+		UnlockAccount(ctx context.Context, in *UnlockAccountRequest, out *Account) error
+		ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *AuthenticateAccountResponse) error
+		ChangeOwnPassword(ctx context.Context, in *ChangeOwnPasswordRequest, out *Account) error
+		EnrollTotp(ctx context.Context, in *EnrollTotpRequest, out *EnrollTotpResponse) error
+		VerifyTotp(ctx context.Context, in *VerifyTotpRequest, out *VerifyTotpResponse) error
+		RemoveTotp(ctx context.Context, in *RemoveTotpRequest, out *Account) error
+		CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, out *CreateAppPasswordResponse) error
+		ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, out *ListAppPasswordsResponse) error
+		RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, out *empty.Empty) error
+		RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *Account) error
+		ValidateSession(ctx context.Context, in *ValidateSessionRequest, out *ValidateSessionResponse) error
 	}
 	type AccountsService struct {
 		accountsService
@@ -218,6 +548,111 @@ func RegisterAccountsServiceHandler(s server.Server, hdlr AccountsServiceHandler
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.SearchPrincipals",
+		Path:    []string{"/api/v0/accounts/principals-search"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ValidateQuery",
+		Path:    []string{"/api/v0/accounts/query-validate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ExplainQuery",
+		Path:    []string{"/api/v0/accounts/query-explain"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.AuthenticateAccount",
+		Path:    []string{"/api/v0/accounts/accounts-authenticate"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.UnlockAccount",
+		Path:    []string{"/api/v0/accounts/accounts-unlock"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ChangePassword",
+		Path:    []string{"/api/v0/accounts/accounts-change-password"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ChangeOwnPassword",
+		Path:    []string{"/api/v0/accounts/accounts-change-own-password"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.EnrollTotp",
+		Path:    []string{"/api/v0/accounts/accounts-totp-enroll"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.VerifyTotp",
+		Path:    []string{"/api/v0/accounts/accounts-totp-verify"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RemoveTotp",
+		Path:    []string{"/api/v0/accounts/accounts-totp-remove"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.CreateAppPassword",
+		Path:    []string{"/api/v0/accounts/accounts-app-passwords-create"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ListAppPasswords",
+		Path:    []string{"/api/v0/accounts/accounts-app-passwords-list"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RevokeAppPassword",
+		Path:    []string{"/api/v0/accounts/accounts-app-passwords-revoke"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.RevokeSessions",
+		Path:    []string{"/api/v0/accounts/accounts-revoke-sessions"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "AccountsService.ValidateSession",
+		Path:    []string{"/api/v0/accounts/accounts-validate-session"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&AccountsService{h}, opts...))
 }
 
@@ -245,6 +680,66 @@ func (h *accountsServiceHandler) DeleteAccount(ctx context.Context, in *DeleteAc
 	return h.AccountsServiceHandler.DeleteAccount(ctx, in, out)
 }
 
+func (h *accountsServiceHandler) SearchPrincipals(ctx context.Context, in *SearchPrincipalsRequest, out *SearchPrincipalsResponse) error {
+	return h.AccountsServiceHandler.SearchPrincipals(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ValidateQuery(ctx context.Context, in *ValidateQueryRequest, out *ValidateQueryResponse) error {
+	return h.AccountsServiceHandler.ValidateQuery(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ExplainQuery(ctx context.Context, in *ExplainQueryRequest, out *ExplainQueryResponse) error {
+	return h.AccountsServiceHandler.ExplainQuery(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) AuthenticateAccount(ctx context.Context, in *AuthenticateAccountRequest, out *AuthenticateAccountResponse) error {
+	return h.AccountsServiceHandler.AuthenticateAccount(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) UnlockAccount(ctx context.Context, in *UnlockAccountRequest, out *Account) error {
+	return h.AccountsServiceHandler.UnlockAccount(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ChangePassword(ctx context.Context, in *ChangePasswordRequest, out *AuthenticateAccountResponse) error {
+	return h.AccountsServiceHandler.ChangePassword(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ChangeOwnPassword(ctx context.Context, in *ChangeOwnPasswordRequest, out *Account) error {
+	return h.AccountsServiceHandler.ChangeOwnPassword(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) EnrollTotp(ctx context.Context, in *EnrollTotpRequest, out *EnrollTotpResponse) error {
+	return h.AccountsServiceHandler.EnrollTotp(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) VerifyTotp(ctx context.Context, in *VerifyTotpRequest, out *VerifyTotpResponse) error {
+	return h.AccountsServiceHandler.VerifyTotp(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RemoveTotp(ctx context.Context, in *RemoveTotpRequest, out *Account) error {
+	return h.AccountsServiceHandler.RemoveTotp(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) CreateAppPassword(ctx context.Context, in *CreateAppPasswordRequest, out *CreateAppPasswordResponse) error {
+	return h.AccountsServiceHandler.CreateAppPassword(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ListAppPasswords(ctx context.Context, in *ListAppPasswordsRequest, out *ListAppPasswordsResponse) error {
+	return h.AccountsServiceHandler.ListAppPasswords(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RevokeAppPassword(ctx context.Context, in *RevokeAppPasswordRequest, out *empty.Empty) error {
+	return h.AccountsServiceHandler.RevokeAppPassword(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) RevokeSessions(ctx context.Context, in *RevokeSessionsRequest, out *Account) error {
+	return h.AccountsServiceHandler.RevokeSessions(ctx, in, out)
+}
+
+func (h *accountsServiceHandler) ValidateSession(ctx context.Context, in *ValidateSessionRequest, out *ValidateSessionResponse) error {
+	return h.AccountsServiceHandler.ValidateSession(ctx, in, out)
+}
+
 // Api Endpoints for GroupsService service
 
 func NewGroupsServiceEndpoints() []*api.Endpoint {
@@ -305,6 +800,34 @@ func NewGroupsServiceEndpoints() []*api.Endpoint {
 			Body:    "*",
 			Handler: "rpc",
 		},
+		&api.Endpoint{
+			Name:    "GroupsService.AddMemberGroup",
+			Path:    []string{"/api/v0/groups/{group_id=*}/member-groups/$ref"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.RemoveMemberGroup",
+			Path:    []string{"/api/v0/groups/{group_id=*}/member-groups/{member_group_id}/$ref"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.ListTransitiveMembers",
+			Path:    []string{"/api/v0/groups/{id=*}/transitive-members"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
+		&api.Endpoint{
+			Name:    "GroupsService.ListTransitiveMemberOf",
+			Path:    []string{"/api/v0/accounts/{account_id=*}/transitive-member-of"},
+			Method:  []string{"POST"},
+			Body:    "*",
+			Handler: "rpc",
+		},
 	}
 }
 
@@ -327,6 +850,14 @@ type GroupsService interface {
 	RemoveMember(ctx context.Context, in *RemoveMemberRequest, opts ...client.CallOption) (*Group, error)
 	// group:listmembers https://docs.microsoft.com/en-us/graph/api/group-list-members?view=graph-rest-1.0
 	ListMembers(ctx context.Context, in *ListMembersRequest, opts ...client.CallOption) (*ListMembersResponse, error)
+	// Adds a group to the members of a group
+	AddMemberGroup(ctx context.Context, in *AddMemberGroupRequest, opts ...client.CallOption) (*Group, error)
+	// Removes a group from the members of a group
+	RemoveMemberGroup(ctx context.Context, in *RemoveMemberGroupRequest, opts ...client.CallOption) (*Group, error)
+	// group:listtransitivemembers https://docs.microsoft.com/en-us/graph/api/group-list-transitivemembers?view=graph-rest-1.0
+	ListTransitiveMembers(ctx context.Context, in *ListTransitiveMembersRequest, opts ...client.CallOption) (*ListTransitiveMembersResponse, error)
+	// user:listtransitivememberof https://docs.microsoft.com/en-us/graph/api/user-list-transitivememberof?view=graph-rest-1.0
+	ListTransitiveMemberOf(ctx context.Context, in *ListTransitiveMemberOfRequest, opts ...client.CallOption) (*ListTransitiveMemberOfResponse, error)
 }
 
 type groupsService struct {
@@ -421,6 +952,46 @@ func (c *groupsService) ListMembers(ctx context.Context, in *ListMembersRequest,
 	return out, nil
 }
 
+func (c *groupsService) AddMemberGroup(ctx context.Context, in *AddMemberGroupRequest, opts ...client.CallOption) (*Group, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.AddMemberGroup", in)
+	out := new(Group)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) RemoveMemberGroup(ctx context.Context, in *RemoveMemberGroupRequest, opts ...client.CallOption) (*Group, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.RemoveMemberGroup", in)
+	out := new(Group)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) ListTransitiveMembers(ctx context.Context, in *ListTransitiveMembersRequest, opts ...client.CallOption) (*ListTransitiveMembersResponse, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.ListTransitiveMembers", in)
+	out := new(ListTransitiveMembersResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *groupsService) ListTransitiveMemberOf(ctx context.Context, in *ListTransitiveMemberOfRequest, opts ...client.CallOption) (*ListTransitiveMemberOfResponse, error) {
+	req := c.c.NewRequest(c.name, "GroupsService.ListTransitiveMemberOf", in)
+	out := new(ListTransitiveMemberOfResponse)
+	err := c.c.Call(ctx, req, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for GroupsService service
 
 type GroupsServiceHandler interface {
@@ -440,6 +1011,14 @@ type GroupsServiceHandler interface {
 	RemoveMember(context.Context, *RemoveMemberRequest, *Group) error
 	// group:listmembers https://docs.microsoft.com/en-us/graph/api/group-list-members?view=graph-rest-1.0
 	ListMembers(context.Context, *ListMembersRequest, *ListMembersResponse) error
+	// Adds a group to the members of a group
+	AddMemberGroup(context.Context, *AddMemberGroupRequest, *Group) error
+	// Removes a group from the members of a group
+	RemoveMemberGroup(context.Context, *RemoveMemberGroupRequest, *Group) error
+	// group:listtransitivemembers https://docs.microsoft.com/en-us/graph/api/group-list-transitivemembers?view=graph-rest-1.0
+	ListTransitiveMembers(context.Context, *ListTransitiveMembersRequest, *ListTransitiveMembersResponse) error
+	// user:listtransitivememberof https://docs.microsoft.com/en-us/graph/api/user-list-transitivememberof?view=graph-rest-1.0
+	ListTransitiveMemberOf(context.Context, *ListTransitiveMemberOfRequest, *ListTransitiveMemberOfResponse) error
 }
 
 func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, opts ...server.HandlerOption) error {
@@ -452,6 +1031,10 @@ func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, op
 		AddMember(ctx context.Context, in *AddMemberRequest, out *Group) error
 		RemoveMember(ctx context.Context, in *RemoveMemberRequest, out *Group) error
 		ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error
+		AddMemberGroup(ctx context.Context, in *AddMemberGroupRequest, out *Group) error
+		RemoveMemberGroup(ctx context.Context, in *RemoveMemberGroupRequest, out *Group) error
+		ListTransitiveMembers(ctx context.Context, in *ListTransitiveMembersRequest, out *ListTransitiveMembersResponse) error
+		ListTransitiveMemberOf(ctx context.Context, in *ListTransitiveMemberOfRequest, out *ListTransitiveMemberOfResponse) error
 	}
 	type GroupsService struct {
 		groupsService
@@ -513,6 +1096,34 @@ func RegisterGroupsServiceHandler(s server.Server, hdlr GroupsServiceHandler, op
 		Body:    "*",
 		Handler: "rpc",
 	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.AddMemberGroup",
+		Path:    []string{"/api/v0/groups/{group_id=*}/member-groups/$ref"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.RemoveMemberGroup",
+		Path:    []string{"/api/v0/groups/{group_id=*}/member-groups/{member_group_id}/$ref"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.ListTransitiveMembers",
+		Path:    []string{"/api/v0/groups/{id=*}/transitive-members"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
+	opts = append(opts, api.WithEndpoint(&api.Endpoint{
+		Name:    "GroupsService.ListTransitiveMemberOf",
+		Path:    []string{"/api/v0/accounts/{account_id=*}/transitive-member-of"},
+		Method:  []string{"POST"},
+		Body:    "*",
+		Handler: "rpc",
+	}))
 	return s.Handle(s.NewHandler(&GroupsService{h}, opts...))
 }
 
@@ -551,3 +1162,19 @@ func (h *groupsServiceHandler) RemoveMember(ctx context.Context, in *RemoveMembe
 func (h *groupsServiceHandler) ListMembers(ctx context.Context, in *ListMembersRequest, out *ListMembersResponse) error {
 	return h.GroupsServiceHandler.ListMembers(ctx, in, out)
 }
+
+func (h *groupsServiceHandler) AddMemberGroup(ctx context.Context, in *AddMemberGroupRequest, out *Group) error {
+	return h.GroupsServiceHandler.AddMemberGroup(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) RemoveMemberGroup(ctx context.Context, in *RemoveMemberGroupRequest, out *Group) error {
+	return h.GroupsServiceHandler.RemoveMemberGroup(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) ListTransitiveMembers(ctx context.Context, in *ListTransitiveMembersRequest, out *ListTransitiveMembersResponse) error {
+	return h.GroupsServiceHandler.ListTransitiveMembers(ctx, in, out)
+}
+
+func (h *groupsServiceHandler) ListTransitiveMemberOf(ctx context.Context, in *ListTransitiveMemberOfRequest, out *ListTransitiveMemberOfResponse) error {
+	return h.GroupsServiceHandler.ListTransitiveMemberOf(ctx, in, out)
+}
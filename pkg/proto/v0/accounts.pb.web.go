@@ -143,40 +143,90 @@ func (h *webAccountsServiceHandler) DeleteAccount(w http.ResponseWriter, r *http
 	render.NoContent(w, r)
 }
 
-func RegisterAccountsServiceWeb(r chi.Router, i AccountsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webAccountsServiceHandler{
-		r: r,
-		h: i,
+func (h *webAccountsServiceHandler) SearchPrincipals(w http.ResponseWriter, r *http.Request) {
+
+	req := &SearchPrincipalsRequest{}
+
+	resp := &SearchPrincipalsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-list", handler.ListAccounts)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-get", handler.GetAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-create", handler.CreateAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-update", handler.UpdateAccount)
-	r.MethodFunc("POST", "/api/v0/accounts/accounts-delete", handler.DeleteAccount)
+	if err := h.h.SearchPrincipals(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-type webGroupsServiceHandler struct {
-	r chi.Router
-	h GroupsServiceHandler
+func (h *webAccountsServiceHandler) ValidateQuery(w http.ResponseWriter, r *http.Request) {
+
+	req := &ValidateQueryRequest{}
+
+	resp := &ValidateQueryResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ValidateQuery(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	h.r.ServeHTTP(w, r)
+func (h *webAccountsServiceHandler) ExplainQuery(w http.ResponseWriter, r *http.Request) {
+
+	req := &ExplainQueryRequest{}
+
+	resp := &ExplainQueryResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ExplainQuery(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) AuthenticateAccount(w http.ResponseWriter, r *http.Request) {
 
-	req := &ListGroupsRequest{}
+	req := &AuthenticateAccountRequest{}
 
-	resp := &ListGroupsResponse{}
+	resp := &AuthenticateAccountResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListGroups(
+	if err := h.h.AuthenticateAccount(
 		r.Context(),
 		req,
 		resp,
@@ -189,18 +239,18 @@ func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Requ
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) UnlockAccount(w http.ResponseWriter, r *http.Request) {
 
-	req := &GetGroupRequest{}
+	req := &UnlockAccountRequest{}
 
-	resp := &Group{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.GetGroup(
+	if err := h.h.UnlockAccount(
 		r.Context(),
 		req,
 		resp,
@@ -213,18 +263,18 @@ func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Reques
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
 
-	req := &CreateGroupRequest{}
+	req := &ChangePasswordRequest{}
 
-	resp := &Group{}
+	resp := &AuthenticateAccountResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.CreateGroup(
+	if err := h.h.ChangePassword(
 		r.Context(),
 		req,
 		resp,
@@ -237,18 +287,18 @@ func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) ChangeOwnPassword(w http.ResponseWriter, r *http.Request) {
 
-	req := &UpdateGroupRequest{}
+	req := &ChangeOwnPasswordRequest{}
 
-	resp := &Group{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.UpdateGroup(
+	if err := h.h.ChangeOwnPassword(
 		r.Context(),
 		req,
 		resp,
@@ -261,9 +311,129 @@ func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) EnrollTotp(w http.ResponseWriter, r *http.Request) {
 
-	req := &DeleteGroupRequest{}
+	req := &EnrollTotpRequest{}
+
+	resp := &EnrollTotpResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.EnrollTotp(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) VerifyTotp(w http.ResponseWriter, r *http.Request) {
+
+	req := &VerifyTotpRequest{}
+
+	resp := &VerifyTotpResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.VerifyTotp(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) RemoveTotp(w http.ResponseWriter, r *http.Request) {
+
+	req := &RemoveTotpRequest{}
+
+	resp := &Account{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveTotp(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) CreateAppPassword(w http.ResponseWriter, r *http.Request) {
+
+	req := &CreateAppPasswordRequest{}
+
+	resp := &CreateAppPasswordResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CreateAppPassword(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) ListAppPasswords(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListAppPasswordsRequest{}
+
+	resp := &ListAppPasswordsResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListAppPasswords(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webAccountsServiceHandler) RevokeAppPassword(w http.ResponseWriter, r *http.Request) {
+
+	req := &RevokeAppPasswordRequest{}
 	resp := &empty.Empty{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -271,7 +441,7 @@ func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Req
 		return
 	}
 
-	if err := h.h.DeleteGroup(
+	if err := h.h.RevokeAppPassword(
 		r.Context(),
 		req,
 		resp,
@@ -284,18 +454,18 @@ func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Req
 	render.NoContent(w, r)
 }
 
-func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) RevokeSessions(w http.ResponseWriter, r *http.Request) {
 
-	req := &AddMemberRequest{}
+	req := &RevokeSessionsRequest{}
 
-	resp := &Group{}
+	resp := &Account{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.AddMember(
+	if err := h.h.RevokeSessions(
 		r.Context(),
 		req,
 		resp,
@@ -308,18 +478,18 @@ func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Reque
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+func (h *webAccountsServiceHandler) ValidateSession(w http.ResponseWriter, r *http.Request) {
 
-	req := &RemoveMemberRequest{}
+	req := &ValidateSessionRequest{}
 
-	resp := &Group{}
+	resp := &ValidateSessionResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.RemoveMember(
+	if err := h.h.ValidateSession(
 		r.Context(),
 		req,
 		resp,
@@ -332,18 +502,55 @@ func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Re
 	render.JSON(w, r, resp)
 }
 
-func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+func RegisterAccountsServiceWeb(r chi.Router, i AccountsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webAccountsServiceHandler{
+		r: r,
+		h: i,
+	}
 
-	req := &ListMembersRequest{}
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-list", handler.ListAccounts)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-get", handler.GetAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-create", handler.CreateAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-update", handler.UpdateAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-delete", handler.DeleteAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/principals-search", handler.SearchPrincipals)
+	r.MethodFunc("POST", "/api/v0/accounts/query-validate", handler.ValidateQuery)
+	r.MethodFunc("POST", "/api/v0/accounts/query-explain", handler.ExplainQuery)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-authenticate", handler.AuthenticateAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-unlock", handler.UnlockAccount)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-change-password", handler.ChangePassword)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-change-own-password", handler.ChangeOwnPassword)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-totp-enroll", handler.EnrollTotp)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-totp-verify", handler.VerifyTotp)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-totp-remove", handler.RemoveTotp)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-app-passwords-create", handler.CreateAppPassword)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-app-passwords-list", handler.ListAppPasswords)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-app-passwords-revoke", handler.RevokeAppPassword)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-revoke-sessions", handler.RevokeSessions)
+	r.MethodFunc("POST", "/api/v0/accounts/accounts-validate-session", handler.ValidateSession)
+}
 
-	resp := &ListMembersResponse{}
+type webGroupsServiceHandler struct {
+	r chi.Router
+	h GroupsServiceHandler
+}
+
+func (h *webGroupsServiceHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.r.ServeHTTP(w, r)
+}
+
+func (h *webGroupsServiceHandler) ListGroups(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListGroupsRequest{}
+
+	resp := &ListGroupsResponse{}
 
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		http.Error(w, err.Error(), http.StatusPreconditionFailed)
 		return
 	}
 
-	if err := h.h.ListMembers(
+	if err := h.h.ListGroups(
 		r.Context(),
 		req,
 		resp,
@@ -356,273 +563,1692 @@ func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Req
 	render.JSON(w, r, resp)
 }
 
-func RegisterGroupsServiceWeb(r chi.Router, i GroupsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
-	handler := &webGroupsServiceHandler{
-		r: r,
-		h: i,
+func (h *webGroupsServiceHandler) GetGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &GetGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
 	}
 
-	r.MethodFunc("POST", "/api/v0/accounts/groups-list", handler.ListGroups)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-get", handler.GetGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-create", handler.CreateGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-update", handler.UpdateGroup)
-	r.MethodFunc("POST", "/api/v0/accounts/groups-delete", handler.DeleteGroup)
-	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/$ref", handler.AddMember)
-	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/{account_id}/$ref", handler.RemoveMember)
-	r.MethodFunc("POST", "/api/v0/groups/{id=*}/members/$ref", handler.ListMembers)
+	if err := h.h.GetGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) CreateGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &CreateGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.CreateGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) UpdateGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &UpdateGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.UpdateGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) DeleteGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &DeleteGroupRequest{}
+	resp := &empty.Empty{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.DeleteGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusNoContent)
+	render.NoContent(w, r)
+}
+
+func (h *webGroupsServiceHandler) AddMember(w http.ResponseWriter, r *http.Request) {
+
+	req := &AddMemberRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AddMember(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) RemoveMember(w http.ResponseWriter, r *http.Request) {
+
+	req := &RemoveMemberRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveMember(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListMembers(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListMembersRequest{}
+
+	resp := &ListMembersResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListMembers(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) AddMemberGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &AddMemberGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.AddMemberGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) RemoveMemberGroup(w http.ResponseWriter, r *http.Request) {
+
+	req := &RemoveMemberGroupRequest{}
+
+	resp := &Group{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.RemoveMemberGroup(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListTransitiveMembers(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListTransitiveMembersRequest{}
+
+	resp := &ListTransitiveMembersResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListTransitiveMembers(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func (h *webGroupsServiceHandler) ListTransitiveMemberOf(w http.ResponseWriter, r *http.Request) {
+
+	req := &ListTransitiveMemberOfRequest{}
+
+	resp := &ListTransitiveMemberOfResponse{}
+
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusPreconditionFailed)
+		return
+	}
+
+	if err := h.h.ListTransitiveMemberOf(
+		r.Context(),
+		req,
+		resp,
+	); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	render.Status(r, http.StatusCreated)
+	render.JSON(w, r, resp)
+}
+
+func RegisterGroupsServiceWeb(r chi.Router, i GroupsServiceHandler, middlewares ...func(http.Handler) http.Handler) {
+	handler := &webGroupsServiceHandler{
+		r: r,
+		h: i,
+	}
+
+	r.MethodFunc("POST", "/api/v0/accounts/groups-list", handler.ListGroups)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-get", handler.GetGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-create", handler.CreateGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-update", handler.UpdateGroup)
+	r.MethodFunc("POST", "/api/v0/accounts/groups-delete", handler.DeleteGroup)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/$ref", handler.AddMember)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/members/{account_id}/$ref", handler.RemoveMember)
+	r.MethodFunc("POST", "/api/v0/groups/{id=*}/members/$ref", handler.ListMembers)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/member-groups/$ref", handler.AddMemberGroup)
+	r.MethodFunc("POST", "/api/v0/groups/{group_id=*}/member-groups/{member_group_id}/$ref", handler.RemoveMemberGroup)
+	r.MethodFunc("POST", "/api/v0/groups/{id=*}/transitive-members", handler.ListTransitiveMembers)
+	r.MethodFunc("POST", "/api/v0/accounts/{account_id=*}/transitive-member-of", handler.ListTransitiveMemberOf)
+}
+
+// ListAccountsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAccountsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAccountsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAccountsRequest)(nil)
+
+// ListAccountsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAccountsRequest) UnmarshalJSON(b []byte) error {
+	return ListAccountsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAccountsRequest)(nil)
+
+// ListAccountsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAccountsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAccountsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAccountsResponse)(nil)
+
+// ListAccountsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAccountsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAccountsResponse) UnmarshalJSON(b []byte) error {
+	return ListAccountsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAccountsResponse)(nil)
+
+// FacetJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Facet. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FacetJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Facet) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := FacetJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Facet)(nil)
+
+// FacetJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Facet. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FacetJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Facet) UnmarshalJSON(b []byte) error {
+	return FacetJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Facet)(nil)
+
+// FacetTermJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of FacetTerm. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FacetTermJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *FacetTerm) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := FacetTermJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*FacetTerm)(nil)
+
+// FacetTermJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of FacetTerm. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var FacetTermJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *FacetTerm) UnmarshalJSON(b []byte) error {
+	return FacetTermJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*FacetTerm)(nil)
+
+// SearchPrincipalsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SearchPrincipalsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SearchPrincipalsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SearchPrincipalsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SearchPrincipalsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SearchPrincipalsRequest)(nil)
+
+// SearchPrincipalsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SearchPrincipalsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SearchPrincipalsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SearchPrincipalsRequest) UnmarshalJSON(b []byte) error {
+	return SearchPrincipalsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SearchPrincipalsRequest)(nil)
+
+// SearchPrincipalsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of SearchPrincipalsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SearchPrincipalsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *SearchPrincipalsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := SearchPrincipalsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*SearchPrincipalsResponse)(nil)
+
+// SearchPrincipalsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of SearchPrincipalsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var SearchPrincipalsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *SearchPrincipalsResponse) UnmarshalJSON(b []byte) error {
+	return SearchPrincipalsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*SearchPrincipalsResponse)(nil)
+
+// PrincipalJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Principal. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PrincipalJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *Principal) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := PrincipalJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*Principal)(nil)
+
+// PrincipalJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Principal. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var PrincipalJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *Principal) UnmarshalJSON(b []byte) error {
+	return PrincipalJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*Principal)(nil)
+
+// ValidateQueryRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateQueryRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateQueryRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateQueryRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateQueryRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateQueryRequest)(nil)
+
+// ValidateQueryRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateQueryRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateQueryRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateQueryRequest) UnmarshalJSON(b []byte) error {
+	return ValidateQueryRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateQueryRequest)(nil)
+
+// ValidateQueryResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateQueryResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateQueryResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ValidateQueryResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ValidateQueryResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ValidateQueryResponse)(nil)
+
+// ValidateQueryResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateQueryResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ValidateQueryResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ValidateQueryResponse) UnmarshalJSON(b []byte) error {
+	return ValidateQueryResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ValidateQueryResponse)(nil)
+
+// QueryErrorJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of QueryError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var QueryErrorJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *QueryError) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := QueryErrorJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*QueryError)(nil)
+
+// QueryErrorJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of QueryError. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var QueryErrorJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *QueryError) UnmarshalJSON(b []byte) error {
+	return QueryErrorJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*QueryError)(nil)
+
+// ExplainQueryRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExplainQueryRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainQueryRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ExplainQueryRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ExplainQueryRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ExplainQueryRequest)(nil)
+
+// ExplainQueryRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExplainQueryRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainQueryRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ExplainQueryRequest) UnmarshalJSON(b []byte) error {
+	return ExplainQueryRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ExplainQueryRequest)(nil)
+
+// ExplainQueryResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExplainQueryResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainQueryResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ExplainQueryResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ExplainQueryResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ExplainQueryResponse)(nil)
+
+// ExplainQueryResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExplainQueryResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainQueryResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ExplainQueryResponse) UnmarshalJSON(b []byte) error {
+	return ExplainQueryResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ExplainQueryResponse)(nil)
+
+// AnalyzedValueJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AnalyzedValue. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AnalyzedValueJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AnalyzedValue) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AnalyzedValueJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AnalyzedValue)(nil)
+
+// AnalyzedValueJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AnalyzedValue. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AnalyzedValueJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AnalyzedValue) UnmarshalJSON(b []byte) error {
+	return AnalyzedValueJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AnalyzedValue)(nil)
+
+// ExplainedHitJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ExplainedHit. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainedHitJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ExplainedHit) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ExplainedHitJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ExplainedHit)(nil)
+
+// ExplainedHitJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ExplainedHit. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ExplainedHitJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ExplainedHit) UnmarshalJSON(b []byte) error {
+	return ExplainedHitJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ExplainedHit)(nil)
+
+// AuthenticateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AuthenticateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AuthenticateAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AuthenticateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AuthenticateAccountRequest)(nil)
+
+// AuthenticateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AuthenticateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AuthenticateAccountRequest) UnmarshalJSON(b []byte) error {
+	return AuthenticateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AuthenticateAccountRequest)(nil)
+
+// AuthenticateAccountResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AuthenticateAccountResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateAccountResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AuthenticateAccountResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AuthenticateAccountResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AuthenticateAccountResponse)(nil)
+
+// AuthenticateAccountResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AuthenticateAccountResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AuthenticateAccountResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AuthenticateAccountResponse) UnmarshalJSON(b []byte) error {
+	return AuthenticateAccountResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AuthenticateAccountResponse)(nil)
+
+// GetAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of GetAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *GetAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := GetAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*GetAccountRequest)(nil)
+
+// GetAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of GetAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var GetAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *GetAccountRequest) UnmarshalJSON(b []byte) error {
+	return GetAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*GetAccountRequest)(nil)
+
+// CreateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateAccountRequest)(nil)
+
+// CreateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateAccountRequest) UnmarshalJSON(b []byte) error {
+	return CreateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateAccountRequest)(nil)
+
+// UpdateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UpdateAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UpdateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UpdateAccountRequest)(nil)
+
+// UpdateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UpdateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UpdateAccountRequest) UnmarshalJSON(b []byte) error {
+	return UpdateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UpdateAccountRequest)(nil)
+
+// DeleteAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := DeleteAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*DeleteAccountRequest)(nil)
+
+// DeleteAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var DeleteAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *DeleteAccountRequest) UnmarshalJSON(b []byte) error {
+	return DeleteAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*DeleteAccountRequest)(nil)
+
+// UnlockAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of UnlockAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UnlockAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *UnlockAccountRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := UnlockAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*UnlockAccountRequest)(nil)
+
+// UnlockAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of UnlockAccountRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var UnlockAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *UnlockAccountRequest) UnmarshalJSON(b []byte) error {
+	return UnlockAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*UnlockAccountRequest)(nil)
+
+// ChangePasswordRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ChangePasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ChangePasswordRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ChangePasswordRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ChangePasswordRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ChangePasswordRequest)(nil)
+
+// ChangePasswordRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ChangePasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ChangePasswordRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ChangePasswordRequest) UnmarshalJSON(b []byte) error {
+	return ChangePasswordRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ChangePasswordRequest)(nil)
+
+// ChangeOwnPasswordRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ChangeOwnPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ChangeOwnPasswordRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ChangeOwnPasswordRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ChangeOwnPasswordRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ChangeOwnPasswordRequest)(nil)
+
+// ChangeOwnPasswordRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ChangeOwnPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ChangeOwnPasswordRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ChangeOwnPasswordRequest) UnmarshalJSON(b []byte) error {
+	return ChangeOwnPasswordRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ChangeOwnPasswordRequest)(nil)
+
+// EnrollTotpRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of EnrollTotpRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EnrollTotpRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *EnrollTotpRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := EnrollTotpRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*EnrollTotpRequest)(nil)
+
+// EnrollTotpRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of EnrollTotpRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EnrollTotpRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *EnrollTotpRequest) UnmarshalJSON(b []byte) error {
+	return EnrollTotpRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*EnrollTotpRequest)(nil)
+
+// EnrollTotpResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of EnrollTotpResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EnrollTotpResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *EnrollTotpResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := EnrollTotpResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*EnrollTotpResponse)(nil)
+
+// EnrollTotpResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of EnrollTotpResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var EnrollTotpResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *EnrollTotpResponse) UnmarshalJSON(b []byte) error {
+	return EnrollTotpResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*EnrollTotpResponse)(nil)
+
+// VerifyTotpRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of VerifyTotpRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyTotpRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *VerifyTotpRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VerifyTotpRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*VerifyTotpRequest)(nil)
+
+// VerifyTotpRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of VerifyTotpRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyTotpRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *VerifyTotpRequest) UnmarshalJSON(b []byte) error {
+	return VerifyTotpRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*VerifyTotpRequest)(nil)
+
+// VerifyTotpResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of VerifyTotpResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyTotpResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *VerifyTotpResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := VerifyTotpResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*VerifyTotpResponse)(nil)
+
+// VerifyTotpResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of VerifyTotpResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var VerifyTotpResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *VerifyTotpResponse) UnmarshalJSON(b []byte) error {
+	return VerifyTotpResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*VerifyTotpResponse)(nil)
+
+// CreateAppPasswordRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateAppPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAppPasswordRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateAppPasswordRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateAppPasswordRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateAppPasswordRequest)(nil)
+
+// CreateAppPasswordRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateAppPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAppPasswordRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateAppPasswordRequest) UnmarshalJSON(b []byte) error {
+	return CreateAppPasswordRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateAppPasswordRequest)(nil)
+
+// CreateAppPasswordResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of CreateAppPasswordResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAppPasswordResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *CreateAppPasswordResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := CreateAppPasswordResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*CreateAppPasswordResponse)(nil)
+
+// CreateAppPasswordResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of CreateAppPasswordResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var CreateAppPasswordResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *CreateAppPasswordResponse) UnmarshalJSON(b []byte) error {
+	return CreateAppPasswordResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*CreateAppPasswordResponse)(nil)
+
+// ListAppPasswordsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAppPasswordsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAppPasswordsRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAppPasswordsRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAppPasswordsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAppPasswordsRequest)(nil)
+
+// ListAppPasswordsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAppPasswordsRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAppPasswordsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAppPasswordsRequest) UnmarshalJSON(b []byte) error {
+	return ListAppPasswordsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAppPasswordsRequest)(nil)
+
+// ListAppPasswordsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListAppPasswordsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAppPasswordsResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListAppPasswordsResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListAppPasswordsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListAppPasswordsResponse)(nil)
+
+// ListAppPasswordsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListAppPasswordsResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListAppPasswordsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListAppPasswordsResponse) UnmarshalJSON(b []byte) error {
+	return ListAppPasswordsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListAppPasswordsResponse)(nil)
+
+// RevokeAppPasswordRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RevokeAppPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeAppPasswordRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RevokeAppPasswordRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RevokeAppPasswordRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RevokeAppPasswordRequest)(nil)
+
+// RevokeAppPasswordRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RevokeAppPasswordRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RevokeAppPasswordRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RevokeAppPasswordRequest) UnmarshalJSON(b []byte) error {
+	return RevokeAppPasswordRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-// ListAccountsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListAccountsRequest. This struct is safe to replace or modify but
+var _ json.Unmarshaler = (*RevokeAppPasswordRequest)(nil)
+
+// RevokeSessionsRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RevokeSessionsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsRequestJSONMarshaler = new(jsonpb.Marshaler)
+var RevokeSessionsRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListAccountsRequest) MarshalJSON() ([]byte, error) {
+func (m *RevokeSessionsRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListAccountsRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := RevokeSessionsRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListAccountsRequest)(nil)
+var _ json.Marshaler = (*RevokeSessionsRequest)(nil)
 
-// ListAccountsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListAccountsRequest. This struct is safe to replace or modify but
+// RevokeSessionsRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RevokeSessionsRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var RevokeSessionsRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListAccountsRequest) UnmarshalJSON(b []byte) error {
-	return ListAccountsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *RevokeSessionsRequest) UnmarshalJSON(b []byte) error {
+	return RevokeSessionsRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListAccountsRequest)(nil)
+var _ json.Unmarshaler = (*RevokeSessionsRequest)(nil)
 
-// ListAccountsResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// ValidateSessionRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateSessionRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsResponseJSONMarshaler = new(jsonpb.Marshaler)
+var ValidateSessionRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *ListAccountsResponse) MarshalJSON() ([]byte, error) {
+func (m *ValidateSessionRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := ListAccountsResponseJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ValidateSessionRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*ListAccountsResponse)(nil)
+var _ json.Marshaler = (*ValidateSessionRequest)(nil)
 
-// ListAccountsResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of ListAccountsResponse. This struct is safe to replace or modify but
+// ValidateSessionRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateSessionRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var ListAccountsResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ValidateSessionRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *ListAccountsResponse) UnmarshalJSON(b []byte) error {
-	return ListAccountsResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ValidateSessionRequest) UnmarshalJSON(b []byte) error {
+	return ValidateSessionRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*ListAccountsResponse)(nil)
+var _ json.Unmarshaler = (*ValidateSessionRequest)(nil)
 
-// GetAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of GetAccountRequest. This struct is safe to replace or modify but
+// ValidateSessionResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ValidateSessionResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var ValidateSessionResponseJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *GetAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *ValidateSessionResponse) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := GetAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := ValidateSessionResponseJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*GetAccountRequest)(nil)
+var _ json.Marshaler = (*ValidateSessionResponse)(nil)
 
-// GetAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of GetAccountRequest. This struct is safe to replace or modify but
+// ValidateSessionResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ValidateSessionResponse. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var GetAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var ValidateSessionResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *GetAccountRequest) UnmarshalJSON(b []byte) error {
-	return GetAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *ValidateSessionResponse) UnmarshalJSON(b []byte) error {
+	return ValidateSessionResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*GetAccountRequest)(nil)
+var _ json.Unmarshaler = (*ValidateSessionResponse)(nil)
 
-// CreateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// RemoveTotpRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RemoveTotpRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var CreateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var RemoveTotpRequestJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *CreateAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *RemoveTotpRequest) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := CreateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := RemoveTotpRequestJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*CreateAccountRequest)(nil)
+var _ json.Marshaler = (*RemoveTotpRequest)(nil)
 
-// CreateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of CreateAccountRequest. This struct is safe to replace or modify but
+// RemoveTotpRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RemoveTotpRequest. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var CreateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var RemoveTotpRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *CreateAccountRequest) UnmarshalJSON(b []byte) error {
-	return CreateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *RemoveTotpRequest) UnmarshalJSON(b []byte) error {
+	return RemoveTotpRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*CreateAccountRequest)(nil)
+var _ json.Unmarshaler = (*RemoveTotpRequest)(nil)
 
-// UpdateAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// AccountJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of Account. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var UpdateAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var AccountJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *UpdateAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *Account) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := UpdateAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AccountJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*UpdateAccountRequest)(nil)
+var _ json.Marshaler = (*Account)(nil)
 
-// UpdateAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of UpdateAccountRequest. This struct is safe to replace or modify but
+// AccountJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of Account. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var UpdateAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AccountJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *UpdateAccountRequest) UnmarshalJSON(b []byte) error {
-	return UpdateAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *Account) UnmarshalJSON(b []byte) error {
+	return AccountJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*UpdateAccountRequest)(nil)
+var _ json.Unmarshaler = (*Account)(nil)
 
-// DeleteAccountRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// MultiFactorProfileJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of MultiFactorProfile. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var DeleteAccountRequestJSONMarshaler = new(jsonpb.Marshaler)
+var MultiFactorProfileJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *DeleteAccountRequest) MarshalJSON() ([]byte, error) {
+func (m *MultiFactorProfile) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := DeleteAccountRequestJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := MultiFactorProfileJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*DeleteAccountRequest)(nil)
+var _ json.Marshaler = (*MultiFactorProfile)(nil)
 
-// DeleteAccountRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of DeleteAccountRequest. This struct is safe to replace or modify but
+// MultiFactorProfileJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of MultiFactorProfile. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var DeleteAccountRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var MultiFactorProfileJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *DeleteAccountRequest) UnmarshalJSON(b []byte) error {
-	return DeleteAccountRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *MultiFactorProfile) UnmarshalJSON(b []byte) error {
+	return MultiFactorProfileJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*DeleteAccountRequest)(nil)
+var _ json.Unmarshaler = (*MultiFactorProfile)(nil)
 
-// AccountJSONMarshaler describes the default jsonpb.Marshaler used by all
-// instances of Account. This struct is safe to replace or modify but
+// AppPasswordJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AppPassword. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AccountJSONMarshaler = new(jsonpb.Marshaler)
+var AppPasswordJSONMarshaler = new(jsonpb.Marshaler)
 
 // MarshalJSON satisfies the encoding/json Marshaler interface. This method
 // uses the more correct jsonpb package to correctly marshal the message.
-func (m *Account) MarshalJSON() ([]byte, error) {
+func (m *AppPassword) MarshalJSON() ([]byte, error) {
 	if m == nil {
 		return json.Marshal(nil)
 	}
 
 	buf := &bytes.Buffer{}
 
-	if err := AccountJSONMarshaler.Marshal(buf, m); err != nil {
+	if err := AppPasswordJSONMarshaler.Marshal(buf, m); err != nil {
 		return nil, err
 	}
 
 	return buf.Bytes(), nil
 }
 
-var _ json.Marshaler = (*Account)(nil)
+var _ json.Marshaler = (*AppPassword)(nil)
 
-// AccountJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
-// instances of Account. This struct is safe to replace or modify but
+// AppPasswordJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AppPassword. This struct is safe to replace or modify but
 // should not be done so concurrently.
-var AccountJSONUnmarshaler = new(jsonpb.Unmarshaler)
+var AppPasswordJSONUnmarshaler = new(jsonpb.Unmarshaler)
 
 // UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
 // uses the more correct jsonpb package to correctly unmarshal the message.
-func (m *Account) UnmarshalJSON(b []byte) error {
-	return AccountJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+func (m *AppPassword) UnmarshalJSON(b []byte) error {
+	return AppPasswordJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
 }
 
-var _ json.Unmarshaler = (*Account)(nil)
+var _ json.Unmarshaler = (*AppPassword)(nil)
 
 // IdentitiesJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Identities. This struct is safe to replace or modify but
@@ -1056,6 +2682,222 @@ func (m *ListMembersResponse) UnmarshalJSON(b []byte) error {
 
 var _ json.Unmarshaler = (*ListMembersResponse)(nil)
 
+// AddMemberGroupRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of AddMemberGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddMemberGroupRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *AddMemberGroupRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := AddMemberGroupRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*AddMemberGroupRequest)(nil)
+
+// AddMemberGroupRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of AddMemberGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var AddMemberGroupRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *AddMemberGroupRequest) UnmarshalJSON(b []byte) error {
+	return AddMemberGroupRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*AddMemberGroupRequest)(nil)
+
+// RemoveMemberGroupRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of RemoveMemberGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveMemberGroupRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *RemoveMemberGroupRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := RemoveMemberGroupRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*RemoveMemberGroupRequest)(nil)
+
+// RemoveMemberGroupRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of RemoveMemberGroupRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var RemoveMemberGroupRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *RemoveMemberGroupRequest) UnmarshalJSON(b []byte) error {
+	return RemoveMemberGroupRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*RemoveMemberGroupRequest)(nil)
+
+// ListTransitiveMembersRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListTransitiveMembersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMembersRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListTransitiveMembersRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListTransitiveMembersRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListTransitiveMembersRequest)(nil)
+
+// ListTransitiveMembersRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListTransitiveMembersRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMembersRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListTransitiveMembersRequest) UnmarshalJSON(b []byte) error {
+	return ListTransitiveMembersRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListTransitiveMembersRequest)(nil)
+
+// ListTransitiveMembersResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListTransitiveMembersResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMembersResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListTransitiveMembersResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListTransitiveMembersResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListTransitiveMembersResponse)(nil)
+
+// ListTransitiveMembersResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListTransitiveMembersResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMembersResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListTransitiveMembersResponse) UnmarshalJSON(b []byte) error {
+	return ListTransitiveMembersResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListTransitiveMembersResponse)(nil)
+
+// ListTransitiveMemberOfRequestJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListTransitiveMemberOfRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMemberOfRequestJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListTransitiveMemberOfRequest) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListTransitiveMemberOfRequestJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListTransitiveMemberOfRequest)(nil)
+
+// ListTransitiveMemberOfRequestJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListTransitiveMemberOfRequest. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMemberOfRequestJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListTransitiveMemberOfRequest) UnmarshalJSON(b []byte) error {
+	return ListTransitiveMemberOfRequestJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListTransitiveMemberOfRequest)(nil)
+
+// ListTransitiveMemberOfResponseJSONMarshaler describes the default jsonpb.Marshaler used by all
+// instances of ListTransitiveMemberOfResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMemberOfResponseJSONMarshaler = new(jsonpb.Marshaler)
+
+// MarshalJSON satisfies the encoding/json Marshaler interface. This method
+// uses the more correct jsonpb package to correctly marshal the message.
+func (m *ListTransitiveMemberOfResponse) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return json.Marshal(nil)
+	}
+
+	buf := &bytes.Buffer{}
+
+	if err := ListTransitiveMemberOfResponseJSONMarshaler.Marshal(buf, m); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+var _ json.Marshaler = (*ListTransitiveMemberOfResponse)(nil)
+
+// ListTransitiveMemberOfResponseJSONUnmarshaler describes the default jsonpb.Unmarshaler used by all
+// instances of ListTransitiveMemberOfResponse. This struct is safe to replace or modify but
+// should not be done so concurrently.
+var ListTransitiveMemberOfResponseJSONUnmarshaler = new(jsonpb.Unmarshaler)
+
+// UnmarshalJSON satisfies the encoding/json Unmarshaler interface. This method
+// uses the more correct jsonpb package to correctly unmarshal the message.
+func (m *ListTransitiveMemberOfResponse) UnmarshalJSON(b []byte) error {
+	return ListTransitiveMemberOfResponseJSONUnmarshaler.Unmarshal(bytes.NewReader(b), m)
+}
+
+var _ json.Unmarshaler = (*ListTransitiveMemberOfResponse)(nil)
+
 // GroupJSONMarshaler describes the default jsonpb.Marshaler used by all
 // instances of Group. This struct is safe to replace or modify but
 // should not be done so concurrently.
@@ -4,10 +4,14 @@ package proto
 type BleveAccount struct {
 	Account
 	BleveType string `json:"bleve_type"`
+	// Source is the json of the account without the password, only set when records are stored in the index
+	Source string `json:"source,omitempty"`
 }
 
 // BleveGroup wraps the generated Group and adds a bleve type that is used to distinguish documents in the index
 type BleveGroup struct {
 	Group
 	BleveType string `json:"bleve_type"`
+	// Source is the json of the group, only set when records are stored in the index
+	Source string `json:"source,omitempty"`
 }
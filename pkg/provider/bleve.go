@@ -1,10 +1,9 @@
 package provider
 
 import (
-	"errors"
-	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
@@ -22,19 +21,24 @@ func BuildBleveQuery(r *godata.GoDataFilterQuery) (query.Query, error) {
 	return recursiveBuildQuery(r.Tree)
 }
 
+// odataError returns a QueryError for the node n. The nodes of the parse tree don't know their position in the filter.
+func odataError(n *godata.ParseNode, format string, args ...interface{}) error {
+	return newQueryError(ODataOperators, n.Token.Value, -1, format, args...)
+}
+
 // Builds the filter recursively using DFS
 func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 	if n.Token.Type == godata.FilterTokenFunc {
 		switch n.Token.Value {
 		case "startswith":
 			if len(n.Children) != 2 {
-				return nil, errors.New("startswith match must have two children")
+				return nil, odataError(n, "startswith match must have two children")
 			}
 			if n.Children[0].Token.Type != godata.FilterTokenLiteral {
-				return nil, errors.New("startswith expected a literal as the first param")
+				return nil, odataError(n, "startswith expected a literal as the first param")
 			}
 			if n.Children[1].Token.Type != godata.FilterTokenString {
-				return nil, errors.New("startswith expected a string as the second param")
+				return nil, odataError(n, "startswith expected a string as the second param")
 			} // remove enclosing ' of string tokens (looks like 'some ol'' string')
 			value := n.Children[1].Token.Value[1 : len(n.Children[1].Token.Value)-1]
 			// unescape '' as '
@@ -45,46 +49,13 @@ func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 			// TODO contains as regex?
 			// TODO endswith as regex?
 		default:
-			return nil, godata.NotImplementedError(n.Token.Value + " is not implemented.")
+			return nil, odataError(n, "not implemented")
 		}
 	}
 	if n.Token.Type == godata.FilterTokenLogical {
 		switch n.Token.Value {
-		case "eq":
-			if len(n.Children) != 2 {
-				return nil, errors.New("equality match must have two children")
-			}
-			if n.Children[0].Token.Type != godata.FilterTokenLiteral {
-				return nil, errors.New("equality expected a literal on the lhs")
-			}
-			if n.Children[1].Token.Type == godata.FilterTokenString {
-				// for escape rules see http://docs.oasis-open.org/odata/odata/v4.01/cs01/part2-url-conventions/odata-v4.01-cs01-part2-url-conventions.html#sec_URLComponents
-				// remove enclosing ' of string tokens (looks like 'some ol'' string')
-				value := n.Children[1].Token.Value[1 : len(n.Children[1].Token.Value)-1]
-				// unescape '' as '
-				unescaped := strings.ReplaceAll(value, "''", "'")
-				// use a match query, so the field mapping, e.g. lowercase is applied to the value
-				// remember we defined the field mapping for `preferred_name` to be lowercase
-				// a term query like `preferred_name eq 'Artur'` would use `Artur` to search in the index and come up empty
-				// a match query will apply the field mapping (lowercasing `Artur` to `artur`) before doing the search
-				// TODO there is a mismatch between the LDAP and odata filters:
-				// - LDAP matching rules depend on the attribute: see https://ldapwiki.com/wiki/MatchingRule
-				// - odata has functions like `startswith`, `contains`, `tolower`, `toupper`, `matchesPattern` andy more: see http://docs.oasis-open.org/odata/odata/v4.01/odata-v4.01-part1-protocol.html#sec_BuiltinQueryFunctions
-				// - ocis-glauth should do the mapping between LDAP and odata filter
-				q := bleve.NewMatchQuery(unescaped)
-				q.SetField(n.Children[0].Token.Value)
-				return q, nil
-			} else if n.Children[1].Token.Type == godata.FilterTokenInteger {
-				v, err := strconv.ParseFloat(n.Children[1].Token.Value, 64)
-				if err != nil {
-					return nil, err
-				}
-				incl := true
-				q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
-				q.SetField(n.Children[0].Token.Value)
-				return q, nil
-			}
-			return nil, fmt.Errorf("equality expected a string or int on the rhs, got %d", n.Children[1].Token.Type)
+		case "eq", "gt", "ge", "lt", "le":
+			return odataComparison(n)
 		case "and":
 			q := query.NewConjunctionQuery([]query.Query{})
 			for _, child := range n.Children {
@@ -111,7 +82,7 @@ func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 			return q, nil
 		case "Not":
 			if len(n.Children) != 1 {
-				return nil, errors.New("not filter must have only one child")
+				return nil, odataError(n, "not filter must have only one child")
 			}
 			subQuery, err := recursiveBuildQuery(n.Children[0])
 			if err != nil {
@@ -120,9 +91,96 @@ func recursiveBuildQuery(n *godata.ParseNode) (query.Query, error) {
 			q := query.NewBooleanQuery(nil, nil, []query.Query{subQuery})
 			return q, nil
 		default:
-			return nil, godata.NotImplementedError(n.Token.Value + " is not implemented.")
+			return nil, odataError(n, "not implemented")
 		}
 	}
 
-	return nil, godata.NotImplementedError(n.Token.Value + " is not implemented.")
+	return nil, odataError(n, "not implemented")
+}
+
+// odataComparison builds the query for a comparison of a field with a string, number, boolean or date time. Timestamps
+// are indexed as the seconds since the epoch, so date times are compared with the `seconds` of the field.
+func odataComparison(n *godata.ParseNode) (query.Query, error) {
+	operator := n.Token.Value
+	if len(n.Children) != 2 {
+		return nil, odataError(n, "comparison must have two children")
+	}
+	if n.Children[0].Token.Type != godata.FilterTokenLiteral {
+		return nil, odataError(n, "comparison expected a literal on the lhs")
+	}
+	field := n.Children[0].Token.Value
+	value := n.Children[1]
+	switch value.Token.Type {
+	case godata.FilterTokenString:
+		// for escape rules see http://docs.oasis-open.org/odata/odata/v4.01/cs01/part2-url-conventions/odata-v4.01-cs01-part2-url-conventions.html#sec_URLComponents
+		// remove enclosing ' of string tokens (looks like 'some ol'' string')
+		unescaped := strings.ReplaceAll(value.Token.Value[1:len(value.Token.Value)-1], "''", "'")
+		if operator != "eq" {
+			inclusive := operator == "ge" || operator == "le"
+			var q *query.TermRangeQuery
+			if operator == "gt" || operator == "ge" {
+				q = bleve.NewTermRangeInclusiveQuery(unescaped, "", &inclusive, nil)
+			} else {
+				q = bleve.NewTermRangeInclusiveQuery("", unescaped, nil, &inclusive)
+			}
+			q.SetField(field)
+			return q, nil
+		}
+		// use a match query, so the field mapping, e.g. lowercase is applied to the value
+		// remember we defined the field mapping for `preferred_name` to be lowercase
+		// a term query like `preferred_name eq 'Artur'` would use `Artur` to search in the index and come up empty
+		// a match query will apply the field mapping (lowercasing `Artur` to `artur`) before doing the search
+		// TODO there is a mismatch between the LDAP and odata filters:
+		// - LDAP matching rules depend on the attribute: see https://ldapwiki.com/wiki/MatchingRule
+		// - odata has functions like `startswith`, `contains`, `tolower`, `toupper`, `matchesPattern` andy more: see http://docs.oasis-open.org/odata/odata/v4.01/odata-v4.01-part1-protocol.html#sec_BuiltinQueryFunctions
+		// - ocis-glauth should do the mapping between LDAP and odata filter
+		q := bleve.NewMatchQuery(unescaped)
+		q.SetField(field)
+		return q, nil
+	case godata.FilterTokenBoolean:
+		if operator != "eq" {
+			return nil, odataError(value, "booleans can only be compared with eq")
+		}
+		q := bleve.NewBoolFieldQuery(value.Token.Value == "true")
+		q.SetField(field)
+		return q, nil
+	case godata.FilterTokenInteger, godata.FilterTokenFloat:
+		v, err := strconv.ParseFloat(value.Token.Value, 64)
+		if err != nil {
+			return nil, odataError(value, "invalid number")
+		}
+		return odataNumericRange(field, operator, v), nil
+	case godata.FilterTokenDateTime:
+		t, err := parseODataDateTime(value.Token.Value)
+		if err != nil {
+			return nil, odataError(value, "invalid date time")
+		}
+		return odataNumericRange(field+".seconds", operator, float64(t.UnixNano())/float64(time.Second)), nil
+	}
+	return nil, odataError(value, "comparison expected a string, number, boolean or date time on the rhs")
+}
+
+// odataNumericRange returns the range of numbers matching the comparison with v
+func odataNumericRange(field string, operator string, v float64) query.Query {
+	inclusive := operator != "gt" && operator != "lt"
+	var q *query.NumericRangeQuery
+	switch operator {
+	case "gt", "ge":
+		q = bleve.NewNumericRangeInclusiveQuery(&v, nil, &inclusive, nil)
+	case "lt", "le":
+		q = bleve.NewNumericRangeInclusiveQuery(nil, &v, nil, &inclusive)
+	default:
+		q = bleve.NewNumericRangeInclusiveQuery(&v, &v, &inclusive, &inclusive)
+	}
+	q.SetField(field)
+	return q
+}
+
+// parseODataDateTime parses a date time literal, the seconds are optional
+func parseODataDateTime(value string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Parse("2006-01-02T15:04Z07:00", value)
+	}
+	return t, nil
 }
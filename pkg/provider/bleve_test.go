@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/CiscoM31/godata"
+	"github.com/blevesearch/bleve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBleveQuery(t *testing.T) {
+	index, err := bleve.NewMemOnly(bleve.NewIndexMapping())
+	assert.NoError(t, err)
+	defer index.Close()
+
+	docs := map[string]map[string]interface{}{
+		"einstein": {"preferred_name": "einstein", "account_enabled": true, "uid_number": 20000, "created_date_time": map[string]interface{}{"seconds": 1600000000}},
+		"marie":    {"preferred_name": "marie", "account_enabled": true, "uid_number": 20001, "created_date_time": map[string]interface{}{"seconds": 1600001000}},
+		"richard":  {"preferred_name": "richard", "account_enabled": false, "uid_number": 20002, "created_date_time": map[string]interface{}{"seconds": 1600002000}},
+	}
+	for id, doc := range docs {
+		assert.NoError(t, index.Index(id, doc))
+	}
+
+	var scenarios = []struct {
+		filter   string
+		expected []string
+	}{
+		{`preferred_name eq 'einstein'`, []string{"einstein"}},
+		{`preferred_name gt 'marie'`, []string{"richard"}},
+		{`preferred_name le 'marie'`, []string{"einstein", "marie"}},
+		{`account_enabled eq false`, []string{"richard"}},
+		{`account_enabled eq true`, []string{"einstein", "marie"}},
+		{`uid_number eq 20001`, []string{"marie"}},
+		{`uid_number gt 20001`, []string{"richard"}},
+		{`uid_number ge 20001`, []string{"marie", "richard"}},
+		{`uid_number lt 20001`, []string{"einstein"}},
+		{`uid_number le 20000.5`, []string{"einstein"}},
+		{`created_date_time eq 2020-09-13T12:43:20Z`, []string{"marie"}},
+		{`created_date_time gt 2020-09-13T12:26:40Z`, []string{"marie", "richard"}},
+		{`created_date_time lt 2020-09-13T12:26:40.5Z`, []string{"einstein"}},
+		{`created_date_time ge 2020-09-13T14:43+02:00`, []string{"marie", "richard"}},
+		{`account_enabled eq true and uid_number gt 20000`, []string{"marie"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.filter, func(t *testing.T) {
+			filter, err := godata.ParseFilterString(scenario.filter)
+			if !assert.NoError(t, err) {
+				return
+			}
+			q, err := BuildBleveQuery(filter)
+			if !assert.NoError(t, err) {
+				return
+			}
+			result, err := index.Search(bleve.NewSearchRequest(q))
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+}
+
+func TestBuildBleveQueryErrors(t *testing.T) {
+	for _, filter := range []string{
+		`account_enabled gt true`,
+		`'einstein' eq preferred_name`,
+		`uid_number eq null`,
+	} {
+		t.Run(filter, func(t *testing.T) {
+			tree, err := godata.ParseFilterString(filter)
+			if !assert.NoError(t, err) {
+				return
+			}
+			_, err = BuildBleveQuery(tree)
+			assert.Error(t, err)
+		})
+	}
+}
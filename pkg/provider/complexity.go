@@ -0,0 +1,40 @@
+package provider
+
+import (
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// QueryComplexity returns how deep the compound queries of q are nested and how many clauses q has. Every leaf
+// query counts as one clause, except for id queries which count every id.
+func QueryComplexity(q query.Query) (depth int, clauses int) {
+	switch q := q.(type) {
+	case nil:
+		return 0, 0
+	case *query.ConjunctionQuery:
+		return compoundComplexity(q.Conjuncts)
+	case *query.DisjunctionQuery:
+		return compoundComplexity(q.Disjuncts)
+	case *query.BooleanQuery:
+		subs := []query.Query{}
+		for _, sub := range []query.Query{q.Must, q.Should, q.MustNot} {
+			if sub != nil {
+				subs = append(subs, sub)
+			}
+		}
+		return compoundComplexity(subs)
+	case *query.DocIDQuery:
+		return 0, len(q.IDs)
+	}
+	return 0, 1
+}
+
+func compoundComplexity(subs []query.Query) (depth int, clauses int) {
+	for _, sub := range subs {
+		d, c := QueryComplexity(sub)
+		if d > depth {
+			depth = d
+		}
+		clauses += c
+	}
+	return depth + 1, clauses
+}
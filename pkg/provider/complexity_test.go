@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQueryComplexity(t *testing.T) {
+	var scenarios = []struct {
+		filter  string
+		depth   int
+		clauses int
+	}{
+		{`userName eq "einstein"`, 0, 1},
+		{`userName eq "einstein" or userName eq "marie"`, 1, 2},
+		{`active eq true and (userName eq "einstein" or userName eq "marie")`, 2, 3},
+		{`active eq true and not (userName eq "einstein" or userName eq "marie")`, 4, 3},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.filter, func(t *testing.T) {
+			q, err := BuildBleveQueryFromSCIMFilter(scenario.filter, SCIMUserAttributes)
+			if !assert.NoError(t, err) {
+				return
+			}
+			depth, clauses := QueryComplexity(q)
+			assert.Equal(t, scenario.depth, depth)
+			assert.Equal(t, scenario.clauses, clauses)
+		})
+	}
+}
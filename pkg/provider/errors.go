@@ -0,0 +1,51 @@
+package provider
+
+import (
+	"fmt"
+)
+
+// The operators supported by the filter languages, reported in query errors
+var (
+	ODataOperators = []string{"eq", "gt", "ge", "lt", "le", "and", "or", "not", "startswith"}
+	SCIMOperators  = []string{"eq", "ne", "co", "sw", "ew", "pr", "gt", "ge", "lt", "le", "and", "or", "not"}
+	LDAPOperators  = []string{"=", "=*", "~=", ">=", "<=", "&", "|", "!"}
+)
+
+// QueryError describes why a filter could not be parsed or translated into a bleve query
+type QueryError struct {
+	Message            string   `json:"message"`
+	Token              string   `json:"token,omitempty"`
+	Position           int      `json:"position"`
+	SupportedOperators []string `json:"supported_operators,omitempty"`
+}
+
+// Error implements the error interface
+func (e *QueryError) Error() string {
+	switch {
+	case e.Token != "" && e.Position >= 0:
+		return fmt.Sprintf("%s: '%s' at position %d", e.Message, e.Token, e.Position)
+	case e.Token != "":
+		return fmt.Sprintf("%s: '%s'", e.Message, e.Token)
+	case e.Position >= 0:
+		return fmt.Sprintf("%s at position %d", e.Message, e.Position)
+	}
+	return e.Message
+}
+
+// AsQueryError returns err as a QueryError. Other errors are wrapped with an unknown position and the given
+// supported operators.
+func AsQueryError(err error, operators []string) *QueryError {
+	if qe, ok := err.(*QueryError); ok {
+		return qe
+	}
+	return &QueryError{Message: err.Error(), Position: -1, SupportedOperators: operators}
+}
+
+func newQueryError(operators []string, token string, position int, format string, args ...interface{}) *QueryError {
+	return &QueryError{
+		Message:            fmt.Sprintf(format, args...),
+		Token:              token,
+		Position:           position,
+		SupportedOperators: operators,
+	}
+}
@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// letters that are not decomposed by unicode normalization
+var foldingReplacer = strings.NewReplacer(
+	"ß", "ss",
+	"æ", "ae",
+	"Æ", "AE",
+	"œ", "oe",
+	"Œ", "OE",
+	"ø", "o",
+	"Ø", "O",
+	"ł", "l",
+	"Ł", "L",
+	"đ", "d",
+	"Đ", "D",
+	"þ", "th",
+	"Þ", "TH",
+)
+
+// Fold removes diacritics, eg. `Müller` becomes `Muller` and `José` becomes `Jose`. The sort fields of the index are
+// folded, so values compared with them have to be folded as well.
+func Fold(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	folded, _, err := transform.String(t, s)
+	if err != nil {
+		folded = s
+	}
+	return foldingReplacer.Replace(folded)
+}
@@ -0,0 +1,355 @@
+package provider
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+)
+
+// ldapDefaultAttributes maps common ldap attributes to the fields in the index
+var ldapDefaultAttributes = map[string]string{
+	"objectclass":    "bleve_type",
+	"entryuuid":      "id",
+	"uid":            "preferred_name",
+	"cn":             "on_premises_sam_account_name",
+	"samaccountname": "on_premises_sam_account_name",
+	"displayname":    "display_name",
+	"mail":           "mail",
+	"description":    "description",
+	"uidnumber":      "uid_number",
+	"gidnumber":      "gid_number",
+	"memberof":       ldapGroupsField,
+}
+
+// ldapGroupsField holds the ids of the groups of an account. Unlike in ldap, memberOf has to be compared with group
+// ids, e.g. `(memberOf=509a9dcd-bb37-4f4f-a01a-19dca27d9cfa)`, distinguished names are rejected.
+const ldapGroupsField = "memberOf.id"
+
+// ldapNumericFields are compared as numbers
+var ldapNumericFields = map[string]bool{
+	"uid_number": true,
+	"gid_number": true,
+}
+
+// ldapObjectClasses maps ldap object classes to the type of the records in the index
+var ldapObjectClasses = map[string]string{
+	"person":               "account",
+	"organizationalperson": "account",
+	"inetorgperson":        "account",
+	"posixaccount":         "account",
+	"user":                 "account",
+	"group":                "group",
+	"groupofnames":         "group",
+	"groupofuniquenames":   "group",
+	"posixgroup":           "group",
+}
+
+// LDAPAttributes returns the mapping of ldap attributes to fields in the index. The attributes configured in the
+// schema take precedence over the defaults. Attribute names are case insensitive.
+func LDAPAttributes(schema config.LDAPSchema) map[string]string {
+	attributes := make(map[string]string, len(ldapDefaultAttributes))
+	for k, v := range ldapDefaultAttributes {
+		attributes[k] = v
+	}
+	configured := map[string]string{
+		schema.AccountID:   "id",
+		schema.Identities:  "identities.issuer_assigned_id",
+		schema.Username:    "on_premises_sam_account_name",
+		schema.DisplayName: "display_name",
+		schema.Mail:        "mail",
+		schema.Groups:      ldapGroupsField,
+	}
+	for k, v := range configured {
+		if k != "" {
+			attributes[strings.ToLower(k)] = v
+		}
+	}
+	return attributes
+}
+
+// BuildBleveQueryFromLDAPFilter parses an RFC 4515 ldap filter like `(&(objectClass=posixAccount)(uid=ein*))`
+// and converts it into a bleve query. Attribute names are translated into fields of the index using attributes,
+// see LDAPAttributes. Extensible matches are not supported and memberOf takes group ids instead of distinguished names.
+func BuildBleveQueryFromLDAPFilter(filter string, attributes map[string]string) (query.Query, error) {
+	p := &ldapParser{input: strings.TrimSpace(filter), attributes: attributes}
+	q, err := p.parseFilter()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos != len(p.input) {
+		return nil, newQueryError(LDAPOperators, p.input[p.pos:], p.pos, "unexpected token")
+	}
+	return q, nil
+}
+
+type ldapParser struct {
+	input      string
+	pos        int
+	attributes map[string]string
+}
+
+// errorf returns a QueryError for the token at the given position
+func (p *ldapParser) errorf(pos int, token string, format string, args ...interface{}) error {
+	return newQueryError(LDAPOperators, token, pos, format, args...)
+}
+
+func (p *ldapParser) peek() byte {
+	if p.pos < len(p.input) {
+		return p.input[p.pos]
+	}
+	return 0
+}
+
+func (p *ldapParser) expect(c byte) error {
+	if p.peek() != c {
+		if p.pos >= len(p.input) {
+			return p.errorf(p.pos, "", "expected '%c', got end of filter", c)
+		}
+		return p.errorf(p.pos, string(p.peek()), "expected '%c'", c)
+	}
+	p.pos++
+	return nil
+}
+
+// filter = "(" filtercomp ")"
+func (p *ldapParser) parseFilter() (query.Query, error) {
+	if err := p.expect('('); err != nil {
+		return nil, err
+	}
+	var q query.Query
+	var err error
+	switch p.peek() {
+	case '&':
+		p.pos++
+		var list []query.Query
+		if list, err = p.parseFilterList(); err == nil {
+			q = bleve.NewConjunctionQuery(list...)
+		}
+	case '|':
+		p.pos++
+		var list []query.Query
+		if list, err = p.parseFilterList(); err == nil {
+			q = bleve.NewDisjunctionQuery(list...)
+		}
+	case '!':
+		p.pos++
+		var sub query.Query
+		if sub, err = p.parseFilter(); err == nil {
+			q = query.NewBooleanQuery(nil, nil, []query.Query{sub})
+		}
+	default:
+		q, err = p.parseItem()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = p.expect(')'); err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+// filterlist = 1*filter
+func (p *ldapParser) parseFilterList() ([]query.Query, error) {
+	list := []query.Query{}
+	for p.peek() == '(' {
+		q, err := p.parseFilter()
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, q)
+	}
+	if len(list) == 0 {
+		return nil, p.errorf(p.pos, "", "expected at least one filter")
+	}
+	return list, nil
+}
+
+// item = simple / present / substring
+func (p *ldapParser) parseItem() (query.Query, error) {
+	start := p.pos
+	for p.pos < len(p.input) && !strings.ContainsRune("=~<>:()", rune(p.input[p.pos])) {
+		p.pos++
+	}
+	attr := strings.TrimSpace(p.input[start:p.pos])
+	if attr == "" {
+		return nil, p.errorf(start, "", "expected attribute")
+	}
+	field, ok := p.attributes[strings.ToLower(attr)]
+	if !ok {
+		return nil, p.errorf(start, attr, "unsupported attribute")
+	}
+
+	var op string
+	switch p.peek() {
+	case '=':
+		op = "="
+	case '~', '<', '>':
+		op = string(p.peek()) + "="
+		p.pos++
+		if p.peek() != '=' {
+			return nil, p.errorf(p.pos, "", "expected '='")
+		}
+	case ':':
+		return nil, p.errorf(p.pos, ":", "extensible matches are not supported")
+	default:
+		return nil, p.errorf(p.pos, "", "expected filter type")
+	}
+	p.pos++
+
+	// read the raw value up to the closing paren, unescaping happens per substring part
+	start = p.pos
+	for p.pos < len(p.input) && p.input[p.pos] != ')' && p.input[p.pos] != '(' {
+		p.pos++
+	}
+	raw := p.input[start:p.pos]
+
+	q, err := assertionQuery(field, op, raw)
+	if err != nil {
+		return nil, p.errorf(start, raw, "%v", err)
+	}
+	return q, nil
+}
+
+// assertionQuery builds the query for the raw assertion value of an item
+func assertionQuery(field string, op string, raw string) (query.Query, error) {
+	if op == "=" && strings.Contains(raw, "*") {
+		if raw == "*" {
+			return presentQuery(field), nil
+		}
+		return ldapSubstringQuery(field, raw)
+	}
+
+	value, err := unescapeLDAPValue(raw)
+	if err != nil {
+		return nil, err
+	}
+	if field == ldapGroupsField && strings.Contains(value, "=") {
+		return nil, fmt.Errorf("expected a group id, distinguished names are not supported")
+	}
+
+	switch op {
+	case "=":
+		return equalityQuery(field, value)
+	case "~=":
+		q := bleve.NewMatchQuery(value)
+		q.SetField(field)
+		q.SetFuzziness(1)
+		return q, nil
+	case ">=", "<=":
+		return rangeQuery(field, op, value)
+	}
+	return nil, fmt.Errorf("unsupported filter type '%s'", op)
+}
+
+// unescapeLDAPValue replaces the `\XX` hex escapes of an assertion value
+func unescapeLDAPValue(raw string) (string, error) {
+	if !strings.Contains(raw, `\`) {
+		return raw, nil
+	}
+	var b strings.Builder
+	for i := 0; i < len(raw); i++ {
+		if raw[i] != '\\' {
+			b.WriteByte(raw[i])
+			continue
+		}
+		if i+2 >= len(raw) {
+			return "", fmt.Errorf("invalid escape sequence")
+		}
+		decoded, err := hex.DecodeString(raw[i+1 : i+3])
+		if err != nil {
+			return "", fmt.Errorf("invalid escape sequence")
+		}
+		b.Write(decoded)
+		i += 2
+	}
+	return b.String(), nil
+}
+
+func presentQuery(field string) query.Query {
+	if field == "bleve_type" {
+		return bleve.NewMatchAllQuery()
+	}
+	q := bleve.NewWildcardQuery("*")
+	q.SetField(field)
+	return q
+}
+
+// ldapSubstringQuery converts `initial*any*final` into a wildcard query
+func ldapSubstringQuery(field string, raw string) (query.Query, error) {
+	parts := strings.Split(raw, "*")
+	for i := range parts {
+		v, err := unescapeLDAPValue(parts[i])
+		if err != nil {
+			return nil, err
+		}
+		if strings.ContainsAny(v, "*?") {
+			return nil, fmt.Errorf("unsupported character in substring")
+		}
+		if field == ldapGroupsField && strings.Contains(v, "=") {
+			return nil, fmt.Errorf("expected a group id, distinguished names are not supported")
+		}
+		parts[i] = v
+	}
+	return substringQuery(field, parts), nil
+}
+
+func equalityQuery(field string, value string) (query.Query, error) {
+	if field == "bleve_type" {
+		t, ok := ldapObjectClasses[strings.ToLower(value)]
+		if !ok {
+			if strings.ToLower(value) == "top" {
+				return bleve.NewMatchAllQuery(), nil
+			}
+			return bleve.NewMatchNoneQuery(), nil
+		}
+		q := bleve.NewTermQuery(t)
+		q.SetField(field)
+		return q, nil
+	}
+	if ldapNumericFields[field] {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number for '%s'", field)
+		}
+		incl := true
+		q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
+		q.SetField(field)
+		return q, nil
+	}
+	// use a match query, so the field mapping, e.g. lowercase is applied to the value
+	q := bleve.NewMatchQuery(value)
+	q.SetField(field)
+	return q, nil
+}
+
+func rangeQuery(field string, op string, value string) (query.Query, error) {
+	incl := true
+	if ldapNumericFields[field] {
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("expected a number for '%s'", field)
+		}
+		var q *query.NumericRangeQuery
+		if op == ">=" {
+			q = bleve.NewNumericRangeInclusiveQuery(&v, nil, &incl, nil)
+		} else {
+			q = bleve.NewNumericRangeInclusiveQuery(nil, &v, nil, &incl)
+		}
+		q.SetField(field)
+		return q, nil
+	}
+	var q *query.TermRangeQuery
+	if op == ">=" {
+		q = bleve.NewTermRangeInclusiveQuery(value, "", &incl, nil)
+	} else {
+		q = bleve.NewTermRangeInclusiveQuery("", value, nil, &incl)
+	}
+	q.SetField(field)
+	return q, nil
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBleveQueryFromLDAPFilter(t *testing.T) {
+	index, err := bleve.NewMemOnly(newSubstringTestMapping())
+	assert.NoError(t, err)
+	defer index.Close()
+
+	docs := map[string]map[string]interface{}{
+		"einstein": {"bleve_type": "account", "on_premises_sam_account_name": "einstein", "on_premises_sam_account_name_sort": "einstein", "display_name": "Albert Einstein", "display_name_sort": "albert einstein", "uid_number": 20000, "mail": "Albert.Einstein@example.org", "mail_sort": "albert.einstein@example.org", "memberOf": []map[string]interface{}{{"id": "509a9dcd-bb37-4f4f-a01a-19dca27d9cfa"}}},
+		"marie":    {"bleve_type": "account", "on_premises_sam_account_name": "marie", "on_premises_sam_account_name_sort": "marie", "display_name": "Marie Curie", "display_name_sort": "marie curie", "uid_number": 20001, "mail": "marie@example.org", "mail_sort": "marie@example.org"},
+		"users":    {"bleve_type": "group", "on_premises_sam_account_name": "users", "on_premises_sam_account_name_sort": "users", "display_name": "Users", "display_name_sort": "users", "gid_number": 30000},
+	}
+	for id, doc := range docs {
+		assert.NoError(t, index.Index(id, doc))
+	}
+
+	attributes := LDAPAttributes(config.LDAPSchema{
+		Username: "sAMAccountName",
+	})
+
+	var scenarios = []struct {
+		filter   string
+		expected []string
+	}{
+		{"(cn=einstein)", []string{"einstein"}},
+		{"(sAMAccountName=marie)", []string{"marie"}},
+		{"(objectClass=posixAccount)", []string{"einstein", "marie"}},
+		{"(&(objectClass=posixGroup)(gidNumber=30000))", []string{"users"}},
+		{"(|(cn=einstein)(cn=marie))", []string{"einstein", "marie"}},
+		{"(&(objectClass=inetOrgPerson)(!(cn=einstein)))", []string{"marie"}},
+		{"(uidNumber>=20001)", []string{"marie"}},
+		{"(uidNumber<=20000)", []string{"einstein"}},
+		{"(cn=ein*)", []string{"einstein"}},
+		{"(cn=*ri*)", []string{"marie"}},
+		{"(mail=*)", []string{"einstein", "marie"}},
+		{"(mail=*Einstein*)", []string{"einstein"}},
+		{"(mail=albert.*)", []string{"einstein"}},
+		{"(displayName=*Albert Ein*)", []string{"einstein"}},
+		{"(displayName=Marie*Curie)", []string{"marie"}},
+		{"(memberOf=509a9dcd-bb37-4f4f-a01a-19dca27d9cfa)", []string{"einstein"}},
+		{"(cn~=einstien)", []string{"einstein"}},
+		{`(cn=\65instein)`, []string{"einstein"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.filter, func(t *testing.T) {
+			q, err := BuildBleveQueryFromLDAPFilter(scenario.filter, attributes)
+			if !assert.NoError(t, err) {
+				return
+			}
+			result, err := index.Search(bleve.NewSearchRequest(q))
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+}
+
+func TestBuildBleveQueryFromLDAPFilterErrors(t *testing.T) {
+	attributes := LDAPAttributes(config.LDAPSchema{})
+	for _, filter := range []string{
+		"",
+		"cn=einstein",
+		"(cn=einstein",
+		"(unknown=einstein)",
+		"(cn:caseExactMatch:=einstein)",
+		"(&)",
+		"(uidNumber=abc)",
+		`(cn=\zz)`,
+		"(cn=einstein))",
+		"(memberOf=cn=users,ou=groups,dc=example,dc=com)",
+		"(memberOf=cn=users*)",
+	} {
+		_, err := BuildBleveQueryFromLDAPFilter(filter, attributes)
+		assert.IsType(t, &QueryError{}, err, filter)
+	}
+}
+
+func TestLDAPFilterErrorDetails(t *testing.T) {
+	_, err := BuildBleveQueryFromLDAPFilter("(&(cn=einstein)(foo=bar))", LDAPAttributes(config.LDAPSchema{}))
+	qe, ok := err.(*QueryError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "foo", qe.Token)
+		assert.Equal(t, 16, qe.Position)
+		assert.Equal(t, LDAPOperators, qe.SupportedOperators)
+	}
+}
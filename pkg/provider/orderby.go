@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// BuildBleveSortOrder converts an OData $orderby expression like `display_name desc, uid_number`
+// into the sort order of a bleve search request. The sortable map translates the property names
+// clients may use into the fields of the index. A property can only be used once.
+func BuildBleveSortOrder(orderBy string, sortable map[string]string) ([]string, error) {
+	order := []string{}
+	if strings.TrimSpace(orderBy) == "" {
+		return order, nil
+	}
+	seen := map[string]struct{}{}
+	for _, item := range strings.Split(orderBy, ",") {
+		parts := strings.Fields(item)
+		if len(parts) == 0 || len(parts) > 2 {
+			return nil, fmt.Errorf("invalid orderby item '%s'", strings.TrimSpace(item))
+		}
+		field, ok := sortable[parts[0]]
+		if !ok {
+			return nil, fmt.Errorf("can not order by '%s'", parts[0])
+		}
+		if _, ok := seen[field]; ok {
+			return nil, fmt.Errorf("can not order by '%s' more than once", parts[0])
+		}
+		seen[field] = struct{}{}
+		if len(parts) == 2 {
+			switch strings.ToLower(parts[1]) {
+			case "asc":
+			case "desc":
+				field = "-" + field
+			default:
+				return nil, fmt.Errorf("invalid sort direction '%s', expected asc or desc", parts[1])
+			}
+		}
+		order = append(order, field)
+	}
+	return order, nil
+}
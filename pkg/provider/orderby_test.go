@@ -0,0 +1,65 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBleveSortOrder(t *testing.T) {
+	sortable := map[string]string{
+		"id":           "_id",
+		"display_name": "display_name_sort",
+		"uid_number":   "uid_number",
+	}
+
+	var scenarios = []struct {
+		orderBy  string
+		expected []string
+	}{
+		{``, []string{}},
+		{`   `, []string{}},
+		{`display_name`, []string{"display_name_sort"}},
+		{`display_name asc`, []string{"display_name_sort"}},
+		{`display_name desc`, []string{"-display_name_sort"}},
+		{`display_name DESC`, []string{"-display_name_sort"}},
+		{`uid_number desc, display_name`, []string{"-uid_number", "display_name_sort"}},
+		{` display_name  desc ,id `, []string{"-display_name_sort", "_id"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.orderBy, func(t *testing.T) {
+			order, err := BuildBleveSortOrder(scenario.orderBy, sortable)
+			assert.NoError(t, err)
+			assert.Equal(t, scenario.expected, order)
+		})
+	}
+}
+
+func TestBuildBleveSortOrderErrors(t *testing.T) {
+	sortable := map[string]string{
+		"id":           "_id",
+		"display_name": "display_name_sort",
+	}
+
+	var scenarios = []struct {
+		name    string
+		orderBy string
+	}{
+		{"unknown field", `mail`},
+		{"unsortable index field", `display_name_sort`},
+		{"field names are case sensitive", `Display_Name`},
+		{"invalid direction", `display_name up`},
+		{"too many parts", `display_name desc id`},
+		{"empty item", `display_name,`},
+		{"duplicate", `display_name, id, display_name`},
+		{"duplicate with other direction", `display_name asc, display_name desc`},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			_, err := BuildBleveSortOrder(scenario.orderBy, sortable)
+			assert.Error(t, err)
+		})
+	}
+}
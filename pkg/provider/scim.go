@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// SCIMUserAttributes maps the SCIM user attributes to the fields of accounts in the index
+var SCIMUserAttributes = map[string]string{
+	"id":             "id",
+	"externalid":     "on_premises_immutable_id",
+	"username":       "preferred_name",
+	"displayname":    "display_name",
+	"name.formatted": "display_name",
+	"emails":         "mail",
+	"emails.value":   "mail",
+	"active":         "account_enabled",
+	"groups":         "memberOf.id",
+	"groups.value":   "memberOf.id",
+}
+
+// SCIMGroupAttributes maps the SCIM group attributes to the fields of groups in the index
+var SCIMGroupAttributes = map[string]string{
+	"id":            "id",
+	"externalid":    "on_premises_immutable_id",
+	"displayname":   "display_name",
+	"members":       "members.id",
+	"members.value": "members.id",
+}
+
+// BuildBleveQueryFromSCIMFilter parses a SCIM filter (RFC 7644, section 3.4.2.2) like
+// `userName eq "bjensen" and emails co "example.com"` and converts it into a bleve query. Attribute names are case
+// insensitive and translated into fields of the index using attributes. Complex value paths like
+// `emails[type eq "work"]` are not supported.
+func BuildBleveQueryFromSCIMFilter(filter string, attributes map[string]string) (query.Query, error) {
+	tokens, err := scimTokenize(filter)
+	if err != nil {
+		return nil, err
+	}
+	p := &scimParser{tokens: tokens, length: len(filter), attributes: attributes}
+	q, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t != nil {
+		return nil, p.errorf(t, "unexpected token")
+	}
+	return q, nil
+}
+
+type scimTokenType int
+
+const (
+	scimWord scimTokenType = iota
+	scimString
+	scimOpenParen
+	scimCloseParen
+	scimOpenBracket
+)
+
+type scimToken struct {
+	typ   scimTokenType
+	value string
+	pos   int
+}
+
+func scimTokenize(filter string) ([]*scimToken, error) {
+	tokens := []*scimToken{}
+	for i := 0; i < len(filter); {
+		c := filter[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, &scimToken{typ: scimOpenParen, value: "(", pos: i})
+			i++
+		case c == ')':
+			tokens = append(tokens, &scimToken{typ: scimCloseParen, value: ")", pos: i})
+			i++
+		case c == '[':
+			tokens = append(tokens, &scimToken{typ: scimOpenBracket, value: "[", pos: i})
+			i++
+		case c == '"':
+			// find the closing quote, skipping escaped characters
+			j := i + 1
+			for ; j < len(filter) && filter[j] != '"'; j++ {
+				if filter[j] == '\\' {
+					j++
+				}
+			}
+			if j >= len(filter) {
+				return nil, newQueryError(SCIMOperators, filter[i:], i, "unterminated string")
+			}
+			var value string
+			if err := json.Unmarshal([]byte(filter[i:j+1]), &value); err != nil {
+				return nil, newQueryError(SCIMOperators, filter[i:j+1], i, "invalid string: %v", err)
+			}
+			tokens = append(tokens, &scimToken{typ: scimString, value: value, pos: i})
+			i = j + 1
+		default:
+			j := i
+			for j < len(filter) && !unicode.IsSpace(rune(filter[j])) && !strings.ContainsRune(`()[]"`, rune(filter[j])) {
+				j++
+			}
+			if j == i {
+				return nil, newQueryError(SCIMOperators, string(c), i, "unexpected character")
+			}
+			tokens = append(tokens, &scimToken{typ: scimWord, value: filter[i:j], pos: i})
+			i = j
+		}
+	}
+	return tokens, nil
+}
+
+type scimParser struct {
+	tokens     []*scimToken
+	pos        int
+	length     int
+	attributes map[string]string
+}
+
+// errorf returns a QueryError for the token t or for the end of the filter if t is nil
+func (p *scimParser) errorf(t *scimToken, format string, args ...interface{}) error {
+	if t == nil {
+		return newQueryError(SCIMOperators, "", p.length, format, args...)
+	}
+	return newQueryError(SCIMOperators, t.value, t.pos, format, args...)
+}
+
+func (p *scimParser) peek() *scimToken {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return nil
+}
+
+func (p *scimParser) next() *scimToken {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+func (p *scimParser) peekKeyword(keyword string) bool {
+	t := p.peek()
+	return t != nil && t.typ == scimWord && strings.EqualFold(t.value, keyword)
+}
+
+// or has the lowest precedence
+func (p *scimParser) parseOr() (query.Query, error) {
+	q, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekKeyword("or") {
+		return q, nil
+	}
+	dq := bleve.NewDisjunctionQuery(q)
+	for p.peekKeyword("or") {
+		p.next()
+		sub, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		dq.AddQuery(sub)
+	}
+	return dq, nil
+}
+
+func (p *scimParser) parseAnd() (query.Query, error) {
+	q, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekKeyword("and") {
+		return q, nil
+	}
+	cq := bleve.NewConjunctionQuery(q)
+	for p.peekKeyword("and") {
+		p.next()
+		sub, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		cq.AddQuery(sub)
+	}
+	return cq, nil
+}
+
+func (p *scimParser) parseUnary() (query.Query, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, p.errorf(nil, "unexpected end of filter")
+	}
+	if p.peekKeyword("not") {
+		p.next()
+		if open := p.next(); open == nil || open.typ != scimOpenParen {
+			return nil, p.errorf(open, "expected '(' after not")
+		}
+		sub, err := p.parseGroup()
+		if err != nil {
+			return nil, err
+		}
+		return query.NewBooleanQuery(nil, nil, []query.Query{sub}), nil
+	}
+	if t.typ == scimOpenParen {
+		p.next()
+		return p.parseGroup()
+	}
+	return p.parseAttrExp()
+}
+
+// parseGroup parses the filter after an opening paren up to the closing paren
+func (p *scimParser) parseGroup() (query.Query, error) {
+	q, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.next(); t == nil || t.typ != scimCloseParen {
+		return nil, p.errorf(t, "expected ')'")
+	}
+	return q, nil
+}
+
+func (p *scimParser) parseAttrExp() (query.Query, error) {
+	attr := p.next()
+	if attr.typ != scimWord {
+		return nil, p.errorf(attr, "expected attribute")
+	}
+	if t := p.peek(); t != nil && t.typ == scimOpenBracket {
+		return nil, p.errorf(t, "value path filters are not supported")
+	}
+	field, ok := p.attributes[strings.ToLower(attr.value)]
+	if !ok {
+		return nil, p.errorf(attr, "unsupported attribute")
+	}
+
+	op := p.next()
+	if op == nil || op.typ != scimWord {
+		return nil, p.errorf(op, "expected operator after '%s'", attr.value)
+	}
+	operator := strings.ToLower(op.value)
+	if operator == "pr" {
+		q := bleve.NewWildcardQuery("*")
+		q.SetField(field)
+		return q, nil
+	}
+
+	value := p.next()
+	if value == nil || (value.typ != scimString && value.typ != scimWord) {
+		return nil, p.errorf(value, "expected value after '%s'", op.value)
+	}
+
+	switch operator {
+	case "eq":
+		return scimEquality(field, value)
+	case "ne":
+		q, err := scimEquality(field, value)
+		if err != nil {
+			return nil, err
+		}
+		return query.NewBooleanQuery(nil, nil, []query.Query{q}), nil
+	case "co", "sw", "ew":
+		if value.typ != scimString {
+			return nil, p.errorf(value, "operator '%s' expects a string", op.value)
+		}
+		if strings.ContainsAny(value.value, "*?") {
+			return nil, p.errorf(value, "wildcards are not supported")
+		}
+		var parts []string
+		switch operator {
+		case "co":
+			parts = []string{"", value.value, ""}
+		case "sw":
+			parts = []string{value.value, ""}
+		case "ew":
+			parts = []string{"", value.value}
+		}
+		return substringQuery(field, parts), nil
+	case "gt", "ge", "lt", "le":
+		return scimRange(field, operator, value)
+	}
+	return nil, p.errorf(op, "unsupported operator")
+}
+
+func scimEquality(field string, value *scimToken) (query.Query, error) {
+	if value.typ == scimString {
+		// use a match query, so the field mapping, e.g. lowercase is applied to the value
+		q := bleve.NewMatchQuery(value.value)
+		q.SetField(field)
+		return q, nil
+	}
+	switch strings.ToLower(value.value) {
+	case "true", "false":
+		q := bleve.NewBoolFieldQuery(strings.ToLower(value.value) == "true")
+		q.SetField(field)
+		return q, nil
+	case "null":
+		return nil, newQueryError(SCIMOperators, value.value, value.pos, "comparing with null is not supported, use pr")
+	}
+	v, err := strconv.ParseFloat(value.value, 64)
+	if err != nil {
+		return nil, newQueryError(SCIMOperators, value.value, value.pos, "invalid value")
+	}
+	incl := true
+	q := bleve.NewNumericRangeInclusiveQuery(&v, &v, &incl, &incl)
+	q.SetField(field)
+	return q, nil
+}
+
+func scimRange(field string, operator string, value *scimToken) (query.Query, error) {
+	inclusive := operator == "ge" || operator == "le"
+	lower := operator == "gt" || operator == "ge"
+	if value.typ == scimString {
+		var q *query.TermRangeQuery
+		if lower {
+			q = bleve.NewTermRangeInclusiveQuery(value.value, "", &inclusive, nil)
+		} else {
+			q = bleve.NewTermRangeInclusiveQuery("", value.value, nil, &inclusive)
+		}
+		q.SetField(field)
+		return q, nil
+	}
+	v, err := strconv.ParseFloat(value.value, 64)
+	if err != nil {
+		return nil, newQueryError(SCIMOperators, value.value, value.pos, "invalid value")
+	}
+	var q *query.NumericRangeQuery
+	if lower {
+		q = bleve.NewNumericRangeInclusiveQuery(&v, nil, &inclusive, nil)
+	} else {
+		q = bleve.NewNumericRangeInclusiveQuery(nil, &v, nil, &inclusive)
+	}
+	q.SetField(field)
+	return q, nil
+}
@@ -0,0 +1,94 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBleveQueryFromSCIMFilter(t *testing.T) {
+	index, err := bleve.NewMemOnly(newSubstringTestMapping())
+	assert.NoError(t, err)
+	defer index.Close()
+
+	docs := map[string]map[string]interface{}{
+		"einstein": {"preferred_name": "einstein", "preferred_name_sort": "einstein", "display_name": "Albert Einstein", "display_name_sort": "albert einstein", "mail": "Albert.Einstein@example.org", "mail_sort": "albert.einstein@example.org", "account_enabled": true, "uid_number": 20000, "memberOf": []map[string]interface{}{{"id": "physics"}}},
+		"marie":    {"preferred_name": "marie", "preferred_name_sort": "marie", "display_name": "Marie Curie", "display_name_sort": "marie curie", "mail": "marie@example.com", "mail_sort": "marie@example.com", "account_enabled": true, "uid_number": 20001},
+		"richard":  {"preferred_name": "richard", "preferred_name_sort": "richard", "display_name": "Richard Feynman", "display_name_sort": "richard feynman", "account_enabled": false, "uid_number": 20002},
+	}
+	for id, doc := range docs {
+		assert.NoError(t, index.Index(id, doc))
+	}
+
+	var scenarios = []struct {
+		filter   string
+		expected []string
+	}{
+		{`userName eq "einstein"`, []string{"einstein"}},
+		{`USERNAME EQ "marie"`, []string{"marie"}},
+		{`userName ne "einstein"`, []string{"marie", "richard"}},
+		{`emails co "example.com"`, []string{"marie"}},
+		{`emails.value ew ".org"`, []string{"einstein"}},
+		{`userName sw "ri"`, []string{"richard"}},
+		{`displayName co "Albert Ein"`, []string{"einstein"}},
+		{`displayName sw "MARIE C"`, []string{"marie"}},
+		{`displayName ew "Feynman"`, []string{"richard"}},
+		{`emails co "Einstein"`, []string{"einstein"}},
+		{`emails sw "Albert."`, []string{"einstein"}},
+		{`emails pr`, []string{"einstein", "marie"}},
+		{`active eq false`, []string{"richard"}},
+		{`groups eq "physics"`, []string{"einstein"}},
+		{`userName eq "einstein" or userName eq "marie"`, []string{"einstein", "marie"}},
+		{`active eq true and not (emails co "example.org")`, []string{"marie"}},
+		{`userName eq "richard" or userName eq "marie" and active eq false`, []string{"richard"}},
+		{`(userName eq "richard" or userName eq "marie") and active eq true`, []string{"marie"}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.filter, func(t *testing.T) {
+			q, err := BuildBleveQueryFromSCIMFilter(scenario.filter, SCIMUserAttributes)
+			if !assert.NoError(t, err) {
+				return
+			}
+			result, err := index.Search(bleve.NewSearchRequest(q))
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+}
+
+func TestBuildBleveQueryFromSCIMFilterErrors(t *testing.T) {
+	for _, filter := range []string{
+		"",
+		`userName`,
+		`userName eq`,
+		`userName eq "einstein`,
+		`unknown eq "einstein"`,
+		`userName xx "einstein"`,
+		`emails[type eq "work"]`,
+		`(userName eq "einstein"`,
+		`userName eq "einstein")`,
+		`not userName eq "einstein"`,
+		`userName eq "einstein" and`,
+		`emails co 42`,
+		`active eq maybe`,
+	} {
+		_, err := BuildBleveQueryFromSCIMFilter(filter, SCIMUserAttributes)
+		assert.IsType(t, &QueryError{}, err, filter)
+	}
+}
+
+func TestSCIMFilterErrorDetails(t *testing.T) {
+	_, err := BuildBleveQueryFromSCIMFilter(`userName eq "einstein" and emails xx "example.org"`, SCIMUserAttributes)
+	qe, ok := err.(*QueryError)
+	if assert.True(t, ok) {
+		assert.Equal(t, "xx", qe.Token)
+		assert.Equal(t, 34, qe.Position)
+		assert.Equal(t, SCIMOperators, qe.SupportedOperators)
+	}
+}
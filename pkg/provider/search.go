@@ -0,0 +1,24 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// BuildBleveSearchQuery builds a relevance ranked query for a free text search. The text is matched against
+// all given fields, using the analyzer configured for each field. A hit only needs to match one of the fields,
+// hits matching multiple fields get a higher score. An empty text matches everything.
+func BuildBleveSearchQuery(text string, fields []string) query.Query {
+	if strings.TrimSpace(text) == "" {
+		return bleve.NewMatchAllQuery()
+	}
+	q := bleve.NewDisjunctionQuery()
+	for _, field := range fields {
+		mq := bleve.NewMatchQuery(text)
+		mq.SetField(field)
+		q.AddQuery(mq)
+	}
+	return q
+}
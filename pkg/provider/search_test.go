@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildBleveSearchQuery(t *testing.T) {
+	// mail is a keyword, the other fields use the standard analyzer
+	indexMapping := bleve.NewIndexMapping()
+	mailMapping := bleve.NewTextFieldMapping()
+	mailMapping.Analyzer = keyword.Name
+	indexMapping.DefaultMapping.AddFieldMappingsAt("mail", mailMapping)
+
+	index, err := bleve.NewMemOnly(indexMapping)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	docs := map[string]map[string]interface{}{
+		"einstein": {"display_name": "Albert Einstein", "description": "Relativity", "mail": "einstein@example.org"},
+		"marie":    {"display_name": "Marie Curie", "description": "Radioactivity", "mail": "marie@example.org"},
+		"richard":  {"display_name": "Richard Feynman", "description": "Feynman diagrams", "mail": "richard@example.org"},
+		"freeman":  {"display_name": "Freeman Dyson", "description": "Worked with Feynman and Albert", "mail": "freeman@example.org"},
+	}
+	for id, doc := range docs {
+		assert.NoError(t, index.Index(id, doc))
+	}
+	fields := []string{"display_name", "description", "mail"}
+
+	var scenarios = []struct {
+		name     string
+		text     string
+		fields   []string
+		expected []string
+	}{
+		{"empty text matches everything", "", fields, []string{"einstein", "marie", "richard", "freeman"}},
+		{"blank text matches everything", "  ", fields, []string{"einstein", "marie", "richard", "freeman"}},
+		{"single field", "curie", fields, []string{"marie"}},
+		{"analyzer ignores case", "EINSTEIN", fields, []string{"einstein"}},
+		{"any field matches", "albert", fields, []string{"einstein", "freeman"}},
+		{"any word matches", "curie relativity", fields, []string{"einstein", "marie"}},
+		{"keywords only match the whole value", "example.org", fields, []string{}},
+		{"keyword match", "richard@example.org", fields, []string{"richard"}},
+		{"only the given fields are searched", "relativity", []string{"display_name", "mail"}, []string{}},
+		{"no fields match nothing", "curie", []string{}, []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			request := bleve.NewSearchRequest(BuildBleveSearchQuery(scenario.text, scenario.fields))
+			request.Size = len(docs)
+			result, err := index.Search(request)
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+
+	t.Run("hits matching multiple fields rank higher", func(t *testing.T) {
+		result, err := index.Search(bleve.NewSearchRequest(BuildBleveSearchQuery("feynman", fields)))
+		assert.NoError(t, err)
+		if assert.Len(t, result.Hits, 2) {
+			assert.Equal(t, "richard", result.Hits[0].ID)
+			assert.Equal(t, "freeman", result.Hits[1].ID)
+		}
+	})
+}
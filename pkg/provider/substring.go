@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+)
+
+// substringFields maps fields to their sort field in the index. The sort fields hold the whole value lowercased and
+// without diacritics, so substrings spanning several words and differing in case still match.
+var substringFields = map[string]string{
+	"display_name":                 "display_name_sort",
+	"preferred_name":               "preferred_name_sort",
+	"on_premises_sam_account_name": "on_premises_sam_account_name_sort",
+	"mail":                         "mail_sort",
+}
+
+// analyzedFields are lowercased by their analyzer and have no sort field
+var analyzedFields = map[string]bool{
+	"description": true,
+}
+
+// substringQuery builds a wildcard query for the parts of a substring, `*` is put between the parts. Wildcards are not
+// analyzed, so the parts are lowercased and folded for fields with a sort field and keep their case for keywords.
+func substringQuery(field string, parts []string) query.Query {
+	normalized := make([]string, len(parts))
+	for i := range parts {
+		switch {
+		case substringFields[field] != "":
+			normalized[i] = Fold(strings.ToLower(parts[i]))
+		case analyzedFields[field]:
+			normalized[i] = strings.ToLower(parts[i])
+		default:
+			normalized[i] = parts[i]
+		}
+	}
+	if sortField, ok := substringFields[field]; ok {
+		field = sortField
+	}
+	q := bleve.NewWildcardQuery(strings.Join(normalized, "*"))
+	q.SetField(field)
+	return q
+}
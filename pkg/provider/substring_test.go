@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/mapping"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/stretchr/testify/assert"
+)
+
+// newSubstringTestMapping maps mail and the sort fields as keywords like the index of the service, the sort fields
+// have to be indexed lowercased and folded by the tests. The other fields use the standard analyzer.
+func newSubstringTestMapping() *mapping.IndexMappingImpl {
+	indexMapping := bleve.NewIndexMapping()
+	keywordMapping := bleve.NewTextFieldMapping()
+	keywordMapping.Analyzer = keyword.Name
+	indexMapping.DefaultMapping.AddFieldMappingsAt("mail", keywordMapping)
+	for _, field := range substringFields {
+		indexMapping.DefaultMapping.AddFieldMappingsAt(field, keywordMapping)
+	}
+	return indexMapping
+}
+
+func TestSubstringQuery(t *testing.T) {
+	var scenarios = []struct {
+		field    string
+		parts    []string
+		expected string
+		expField string
+	}{
+		{"display_name", []string{"", "Albert Ein", ""}, "*albert ein*", "display_name_sort"},
+		{"mail", []string{"", "Einstein", ""}, "*einstein*", "mail_sort"},
+		{"preferred_name", []string{"Jürgen", ""}, "jurgen*", "preferred_name_sort"},
+		{"description", []string{"", "Relativity", ""}, "*relativity*", "description"},
+		{"id", []string{"", "ABC"}, "*ABC", "id"},
+	}
+	for _, scenario := range scenarios {
+		q := substringQuery(scenario.field, scenario.parts).(*query.WildcardQuery)
+		assert.Equal(t, scenario.expected, q.Wildcard, scenario.field)
+		assert.Equal(t, scenario.expField, q.Field(), scenario.field)
+	}
+}
@@ -0,0 +1,26 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/micro/go-micro/v2/metadata"
+)
+
+// forwardedHeaders are passed to the handlers, they are only used when the request comes from a trusted proxy
+var forwardedHeaders = []string{"X-Forwarded-For", "X-Real-Ip"}
+
+// remoteAddr passes the address of the client connection to the handlers in the request metadata, like the grpc
+// server of go-micro does, along with the forwarded headers. It must be used before middleware.RealIP, which
+// replaces the address with the headers sent by the client for all following handlers.
+func remoteAddr(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		md := metadata.Metadata{"Remote": r.RemoteAddr}
+		for _, h := range forwardedHeaders {
+			if v := r.Header.Get(h); v != "" {
+				md[h] = v
+			}
+		}
+		ctx := metadata.MergeContext(r.Context(), md, true)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
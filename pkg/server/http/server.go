@@ -27,6 +27,7 @@ func Server(opts ...Option) http.Service {
 
 	mux := chi.NewMux()
 
+	mux.Use(remoteAddr)
 	mux.Use(middleware.RealIP)
 	mux.Use(middleware.RequestID)
 	mux.Use(middleware.Cache)
@@ -8,8 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
@@ -17,19 +19,22 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	fieldmask_utils "github.com/mennanov/fieldmask-utils"
 	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/password"
 	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
 	"github.com/owncloud/ocis-accounts/pkg/provider"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
 	"github.com/owncloud/ocis-pkg/v2/roles"
 	settings "github.com/owncloud/ocis-settings/pkg/proto/v0"
 	settings_svc "github.com/owncloud/ocis-settings/pkg/service/v0"
 	"github.com/rs/zerolog"
-	"golang.org/x/crypto/bcrypt"
 	"google.golang.org/genproto/protobuf/field_mask"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-const (
-	_hashDifficulty = 12
+var (
+	defaultPasswordsOnce sync.Once
+	defaultPasswords     *password.Registry
 )
 
 // accLock mutually exclude readers from writers on account files
@@ -66,6 +71,13 @@ func (s Service) indexAccount(id string) error {
 		return err
 	}
 	s.log.Debug().Interface("account", a).Msg("found account")
+	if s.Config.Search.StoreRecords {
+		var err error
+		if a.Source, err = accountSource(&a.Account); err != nil {
+			s.log.Error().Err(err).Str("account", id).Msg("could not marshal account")
+			return err
+		}
+	}
 	if err := s.index.Index(a.Id, a); err != nil {
 		s.log.Error().Err(err).Interface("account", a).Msg("could not index account")
 		return err
@@ -73,7 +85,7 @@ func (s Service) indexAccount(id string) error {
 	return nil
 }
 
-// an auth request is currently hardcoded and has to match this regex
+// authQuery matches auth requests sent as a ListAccounts query by clients that don't use AuthenticateAccount yet
 // login eq \"teddy\" and password eq \"F&1!b90t111!\"
 var authQuery = regexp.MustCompile(`^login eq '(.*)' and password eq '(.*)'$`) // TODO how is ' escaped in the password?
 
@@ -112,19 +124,37 @@ func (s Service) expandMemberOf(a *proto.Account) {
 	if a == nil {
 		return
 	}
-	expanded := []*proto.Group{}
-	for i := range a.MemberOf {
-		g := &proto.Group{}
-		// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
-		if err := s.loadGroup(a.MemberOf[i].Id, g); err == nil {
-			g.Members = nil // always hide members when expanding
-			expanded = append(expanded, g)
-		} else {
-			// log errors but continue execution for now
-			s.log.Error().Err(err).Str("id", a.MemberOf[i].Id).Msg("could not load group")
+	s.expandAccountsMemberOf([]*proto.Account{a})
+}
+
+// expandAccountsMemberOf replaces the groups of the accounts with the full groups, loading every group only once.
+// When Config.Groups.TransitiveMemberOf is set the groups of nested groups are included.
+func (s Service) expandAccountsMemberOf(accounts []*proto.Account) {
+	if s.Config.Groups.TransitiveMemberOf {
+		for _, a := range accounts {
+			a.MemberOf = s.transitiveMemberOf(a)
+		}
+		return
+	}
+	ids := []string{}
+	for _, a := range accounts {
+		for i := range a.MemberOf {
+			ids = append(ids, a.MemberOf[i].Id)
 		}
 	}
-	a.MemberOf = expanded
+	groups := s.loadGroups(ids)
+	for _, a := range accounts {
+		expanded := []*proto.Group{}
+		for i := range a.MemberOf {
+			// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
+			if g, ok := groups[a.MemberOf[i].Id]; ok {
+				c := *g
+				c.Members = nil // always hide members when expanding
+				expanded = append(expanded, &c)
+			}
+		}
+		a.MemberOf = expanded
+	}
 }
 
 // deflateMemberOf replaces the groups of a user with an instance that only contains the id
@@ -144,14 +174,21 @@ func (s Service) deflateMemberOf(a *proto.Account) {
 	a.MemberOf = deflated
 }
 
-func (s Service) passwordIsValid(hash string, pwd string) (ok bool) {
-	defer func() {
-		if r := recover(); r != nil {
-			s.log.Error().Err(fmt.Errorf("%s", r)).Str("hash", hash).Msg("password lib panicked")
-		}
-	}()
+// passwordIsValid checks the password against the hash. When the password matches, rehash is true if the hash was not
+// generated with the configured algorithm and cost.
+func (s Service) passwordIsValid(hash string, pwd string) (ok bool, rehash bool) {
+	return s.passwordHashers().Verify(hash, pwd)
+}
 
-	return bcrypt.CompareHashAndPassword([]byte(hash), []byte(pwd)) == nil
+// passwordHashers returns the configured password hashers, or the default hashers if the service was not created with New
+func (s Service) passwordHashers() *password.Registry {
+	if s.passwords != nil {
+		return s.passwords
+	}
+	defaultPasswordsOnce.Do(func() {
+		defaultPasswords, _ = password.NewRegistry("", 0)
+	})
+	return defaultPasswords
 }
 
 func (s Service) accountExists(ctx context.Context, username, mail, id string) (exists bool, err error) {
@@ -205,6 +242,60 @@ func (s Service) hasAccountManagementPermissions(ctx context.Context) bool {
 	return s.RoleManager.FindPermissionByID(ctx, roleIDs, AccountManagementPermissionID) != nil
 }
 
+// isOwnAccount returns true if the request was made by the account with the given id
+func (s Service) isOwnAccount(ctx context.Context, id string) bool {
+	accountID, ok := metadata.Get(ctx, middleware.AccountID)
+	return ok && accountID != "" && accountID == id
+}
+
+// loadManagedAccount loads an account for a request that users can make for their own account and managers for any
+// account. It returns true if the caller has account management permissions.
+func (s Service) loadManagedAccount(ctx context.Context, accountID string, method string, a *proto.Account) (manager bool, err error) {
+	if accountID == "" {
+		return false, merrors.BadRequest(s.id, "account id missing")
+	}
+	var id string
+	if id, err = cleanupID(accountID); err != nil {
+		return false, merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+	manager = s.hasAccountManagementPermissions(ctx)
+	if !manager && !s.isOwnAccount(ctx, id) {
+		return false, merrors.Forbidden(s.id, "no permission for %s", method)
+	}
+	if err = s.loadAccount(id, a); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return manager, err
+	}
+	return manager, nil
+}
+
+// persistAccount writes and indexes a changed account
+func (s Service) persistAccount(a *proto.Account) error {
+	if err := s.writeAccount(a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist account")
+		return merrors.InternalServerError(s.id, "could not persist account: %v", err.Error())
+	}
+	if err := s.indexAccount(a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	return nil
+}
+
+// sortableAccountFields maps the properties accounts can be ordered by to their sortable field in the index
+var sortableAccountFields = map[string]string{
+	"id":                           "_id",
+	"display_name":                 "display_name_sort",
+	"preferred_name":               "preferred_name_sort",
+	"on_premises_sam_account_name": "on_premises_sam_account_name_sort",
+	"mail":                         "mail_sort",
+	"uid_number":                   "uid_number",
+	"gid_number":                   "gid_number",
+	"created_date_time":            "created_date_time.seconds",
+}
+
+// searchableAccountFields are the fields used for free text searches on accounts
+var searchableAccountFields = []string{"display_name", "preferred_name", "mail", "description"}
+
 // ListAccounts implements the AccountsServiceHandler interface
 // the query contains account properties
 func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest, out *proto.ListAccountsResponse) (err error) {
@@ -214,16 +305,14 @@ func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest
 
 	accLock.Lock()
 	defer accLock.Unlock()
-	var password string
 
-	// check if this looks like an auth request
-	match := authQuery.FindStringSubmatch(in.Query)
+	// check if this looks like an auth request, only odata queries are used for that
+	var match []string
+	if in.FilterLanguage == "" || strings.EqualFold(in.FilterLanguage, filterLanguageOData) {
+		match = authQuery.FindStringSubmatch(in.Query)
+	}
 	if len(match) == 3 {
-		in.Query = fmt.Sprintf("on_premises_sam_account_name eq '%s'", match[1]) // todo fetch email? make query configurable
-		password = match[2]
-		if password == "" {
-			return merrors.Unauthorized(s.id, "password must not be empty")
-		}
+		return s.listAuthenticatedAccount(ctx, match[1], match[2], in.FieldMask, out)
 	}
 
 	// only search for accounts
@@ -233,65 +322,71 @@ func (s Service) ListAccounts(ctx context.Context, in *proto.ListAccountsRequest
 	query := bleve.NewConjunctionQuery(tq)
 
 	if in.Query != "" {
-		// parse the query like an odata filter
-		var q *godata.GoDataFilterQuery
-		if q, err = godata.ParseFilterString(in.Query); err != nil {
-			s.log.Error().Err(err).Msg("could not parse query")
-			return merrors.InternalServerError(s.id, "could not parse query: %v", err.Error())
-		}
-
-		// convert to bleve query
-		bq, err := provider.BuildBleveQuery(q)
+		bq, err := s.buildFilterQuery(in.FilterLanguage, in.Query, provider.SCIMUserAttributes)
 		if err != nil {
-			s.log.Error().Err(err).Msg("could not build bleve query")
-			return merrors.InternalServerError(s.id, "could not build bleve query: %v", err.Error())
+			return err
 		}
 		query.AddQuery(bq)
 	}
 
+	if in.Search != "" {
+		query.AddQuery(provider.BuildBleveSearchQuery(in.Search, searchableAccountFields))
+	}
+
 	s.log.Debug().Interface("query", query).Msg("using query")
 
-	searchRequest := bleve.NewSearchRequest(query)
-	var searchResult *bleve.SearchResult
-	searchResult, err = s.index.Search(searchRequest)
+	order, err := s.sortOrder(in.OrderBy, sortableAccountFields, in.Search != "")
 	if err != nil {
-		s.log.Error().Err(err).Msg("could not execute bleve search")
-		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+		return err
 	}
 
-	s.log.Debug().Interface("result", searchResult).Msg("result")
+	mask, err := s.readMask(in.FieldMask)
+	if err != nil {
+		return err
+	}
+
+	facets, err := s.facetsRequest(in.Facets, accountFacetFields)
+	if err != nil {
+		return err
+	}
+
+	searchResult, nextPageToken, err := s.searchPage(ctx, query, order, facets, in.PageSize, in.PageToken, "account", in.FilterLanguage, in.Query, in.Search)
+	if err != nil {
+		return err
+	}
 
 	out.Accounts = make([]*proto.Account, 0)
+	out.NextPageToken = nextPageToken
+	if in.IncludeTotalSize {
+		out.TotalSize = int32(searchResult.Total)
+	}
+	if len(in.Facets) > 0 {
+		out.Facets = facetsResponse(in.Facets, searchResult.Facets)
+	}
 
+	accounts := make([]*proto.Account, 0, len(searchResult.Hits))
 	for _, hit := range searchResult.Hits {
 		a := &proto.Account{}
-		if err = s.loadAccount(hit.ID, a); err != nil {
+		if err = s.accountFromHit(hit, a); err != nil {
 			s.log.Error().Err(err).Str("account", hit.ID).Msg("could not load account, skipping")
 			continue
 		}
-		var currentHash string
-		if a.PasswordProfile != nil {
-			currentHash = a.PasswordProfile.Password
-		}
 
 		s.debugLogAccount(a).Msg("found account")
 
-		if password != "" {
-			if a.PasswordProfile == nil {
-				s.debugLogAccount(a).Msg("no password profile")
-				return merrors.Unauthorized(s.id, "invalid password")
-			}
-			if !s.passwordIsValid(currentHash, password) {
-				return merrors.Unauthorized(s.id, "invalid password")
-			}
-		}
-		// TODO add groups if requested
-		// if in.FieldMask ...
-		s.expandMemberOf(a)
+		accounts = append(accounts, a)
+	}
+
+	if expansionRequested(mask, "MemberOf") {
+		s.expandAccountsMemberOf(accounts)
+	}
 
-		// remove password before returning
-		if a.PasswordProfile != nil {
-			a.PasswordProfile.Password = ""
+	for _, a := range accounts {
+		// remove passwords before returning
+		removeSecrets(a)
+
+		if a, err = s.projectAccount(mask, a); err != nil {
+			return err
 		}
 
 		out.Accounts = append(out.Accounts, a)
@@ -306,6 +401,11 @@ func (s Service) GetAccount(ctx context.Context, in *proto.GetAccountRequest, ou
 		return merrors.Forbidden(s.id, "no permission for GetAccount")
 	}
 
+	mask, err := s.readMask(in.FieldMask)
+	if err != nil {
+		return err
+	}
+
 	accLock.Lock()
 	defer accLock.Unlock()
 	var id string
@@ -320,15 +420,18 @@ func (s Service) GetAccount(ctx context.Context, in *proto.GetAccountRequest, ou
 
 	s.debugLogAccount(out).Msg("found account")
 
-	// TODO add groups if requested
-	// if in.FieldMask ...
-	s.expandMemberOf(out)
-
-	// remove password
-	if out.PasswordProfile != nil {
-		out.PasswordProfile.Password = ""
+	if expansionRequested(mask, "MemberOf") {
+		s.expandMemberOf(out)
 	}
 
+	// remove passwords
+	removeSecrets(out)
+
+	projected, err := s.projectAccount(mask, out)
+	if err != nil {
+		return err
+	}
+	*out = *projected
 	return
 }
 
@@ -367,19 +470,37 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 		return merrors.BadRequest(s.id, "account already exists")
 	}
 
+	acc.CreatedDateTime = timestamppb.Now()
+
+	// the lockout is only managed by the authentication
+	acc.LockedUntilDateTime = nil
+	acc.FailedSignInAttempts = 0
+
+	// multi-factor authentication and app passwords are only managed with their own requests
+	acc.MultiFactorProfile = nil
+	acc.AppPasswords = nil
+
 	if acc.PasswordProfile != nil {
+		if err := passwordPoliciesValid(acc.PasswordProfile.PasswordPolicies); err != nil {
+			return merrors.BadRequest(s.id, "%s", err)
+		}
+
+		// the history is only managed by password changes
+		acc.PasswordProfile.PasswordHistory = nil
+
 		if acc.PasswordProfile.Password != "" {
+			if err := s.checkPasswordPolicy(acc, acc.PasswordProfile.Password); err != nil {
+				acc.PasswordProfile.Password = ""
+				return err
+			}
 			// encrypt password
-			hashed, err := bcrypt.GenerateFromPassword([]byte(acc.PasswordProfile.Password), _hashDifficulty)
+			hashed, err := s.passwordHashers().Hash(acc.PasswordProfile.Password)
 			if err != nil {
 				s.log.Error().Err(err).Str("id", id).Msg("could not hash password")
 				return merrors.InternalServerError(s.id, "could not hash password: %v", err.Error())
 			}
-			acc.PasswordProfile.Password = string(hashed)
-		}
-
-		if err := passwordPoliciesValid(acc.PasswordProfile.PasswordPolicies); err != nil {
-			return merrors.BadRequest(s.id, "%s", err)
+			acc.PasswordProfile.Password = hashed
+			acc.PasswordProfile.LastPasswordChangeDateTime = acc.CreatedDateTime
 		}
 	}
 
@@ -397,9 +518,7 @@ func (s Service) CreateAccount(ctx context.Context, in *proto.CreateAccountReque
 	}
 	s.log.Debug().Interface("account", acc).Msg("account after indexing")
 
-	if acc.PasswordProfile != nil {
-		acc.PasswordProfile.Password = ""
-	}
+	removeSecrets(acc)
 
 	{
 		out.Id = acc.Id
@@ -432,8 +551,6 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		return merrors.Forbidden(s.id, "no permission for UpdateAccount")
 	}
 
-	accLock.Lock()
-	defer accLock.Unlock()
 	var id string
 	if in.Account == nil {
 		return merrors.BadRequest(s.id, "account missing")
@@ -446,6 +563,18 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
 
+	// comparing a new password with the history is slow, it must not block other requests
+	var checked []string
+	if pwd := in.Account.GetPasswordProfile().GetPassword(); pwd != "" {
+		if checked, err = s.precheckPasswordReuse(id, pwd); err != nil {
+			in.Account.PasswordProfile.Password = ""
+			return err
+		}
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
 	path := filepath.Join(s.Config.Server.AccountsDataPath, "accounts", id)
 
 	if err = s.loadAccount(id, out); err != nil {
@@ -464,6 +593,10 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		return merrors.BadRequest(s.id, "%s", err)
 	}
 
+	// the mask can replace the password hash with the new password
+	currentPassword := out.GetPasswordProfile().GetPassword()
+	wasEnabled := out.AccountEnabled
+
 	if err := fieldmask_utils.StructToStruct(validMask, in.Account, out); err != nil {
 		return merrors.InternalServerError(s.id, "%s", err)
 	}
@@ -472,28 +605,41 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		if out.PasswordProfile == nil {
 			out.PasswordProfile = &proto.PasswordProfile{}
 		}
+		if err := passwordPoliciesValid(in.Account.PasswordProfile.PasswordPolicies); err != nil {
+			return merrors.BadRequest(s.id, "%s", err)
+		}
+
 		if in.Account.PasswordProfile.Password != "" {
+			if err := s.checkPasswordPolicy(out, in.Account.PasswordProfile.Password); err != nil {
+				in.Account.PasswordProfile.Password = ""
+				return err
+			}
+			if _, err := s.checkPasswordReuse(currentPassword, out.PasswordProfile.PasswordHistory, in.Account.PasswordProfile.Password, checked); err != nil {
+				in.Account.PasswordProfile.Password = ""
+				return err
+			}
 			// encrypt password
-			hashed, err := bcrypt.GenerateFromPassword([]byte(in.Account.PasswordProfile.Password), _hashDifficulty)
+			hashed, err := s.passwordHashers().Hash(in.Account.PasswordProfile.Password)
 			if err != nil {
 				in.Account.PasswordProfile.Password = ""
 				s.log.Error().Err(err).Str("id", id).Msg("could not hash password")
 				return merrors.InternalServerError(s.id, "could not hash password: %v", err.Error())
 			}
-			out.PasswordProfile.Password = string(hashed)
+			out.PasswordProfile.PasswordHistory = s.appendPasswordHistory(out.PasswordProfile.PasswordHistory, currentPassword)
+			out.PasswordProfile.Password = hashed
 			in.Account.PasswordProfile.Password = ""
-		}
 
-		if err := passwordPoliciesValid(in.Account.PasswordProfile.PasswordPolicies); err != nil {
-			return merrors.BadRequest(s.id, "%s", err)
-		}
+			// lastPasswordChangeDateTime calculated, see password
+			out.PasswordProfile.LastPasswordChangeDateTime = tsnow
 
-		// lastPasswordChangeDateTime calculated, see password
-		out.PasswordProfile.LastPasswordChangeDateTime = tsnow
+			// sessions started with the old password must not outlive it
+			revokeSessions(out, t)
+		}
 	}
 
-	// out.RefreshTokensValidFromDateTime TODO use to invalidate all existing sessions
-	// out.SignInSessionsValidFromDateTime TODO use to invalidate all existing sessions
+	if wasEnabled && !out.AccountEnabled {
+		revokeSessions(out, t)
+	}
 
 	// ... TODO on prem for sync
 
@@ -512,10 +658,8 @@ func (s Service) UpdateAccount(ctx context.Context, in *proto.UpdateAccountReque
 		return merrors.InternalServerError(s.id, "could not index updated account: %v", err.Error())
 	}
 
-	// remove password
-	if out.PasswordProfile != nil {
-		out.PasswordProfile.Password = ""
-	}
+	// remove passwords
+	removeSecrets(out)
 
 	return
 }
@@ -621,6 +765,76 @@ func passwordPoliciesValid(policies []string) error {
 	return nil
 }
 
+// readMask converts the field mask of a read request. Paths use the go field names, eg. `DisplayName` or
+// `MemberOf.DisplayName`, or the proto field names, eg. `display_name` or `member_of.display_name`. An empty mask
+// selects all fields, including the expanded related records.
+func (s Service) readMask(mask *field_mask.FieldMask) (fieldmask_utils.Mask, error) {
+	if mask == nil || len(mask.Paths) == 0 {
+		return fieldmask_utils.Mask{}, nil
+	}
+	m, err := fieldmask_utils.MaskFromPaths(mask.Paths, goFieldName)
+	if err != nil {
+		return nil, merrors.BadRequest(s.id, "invalid field mask: %v", err.Error())
+	}
+	return m, nil
+}
+
+// goFieldName converts a proto field name like `member_of` to the name of the go field, `MemberOf`. Go field names
+// are not changed.
+func goFieldName(name string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// expansionRequested checks if the related records in field need to be loaded to satisfy the mask. This is not
+// the case when the field is not part of the mask or when only the ids of the related records were requested.
+func expansionRequested(mask fieldmask_utils.Mask, field string) bool {
+	if mask.IsEmpty() {
+		return true
+	}
+	sub, ok := mask.Get(field)
+	if !ok {
+		return false
+	}
+	if sub.IsEmpty() {
+		return true
+	}
+	subMask, ok := sub.(fieldmask_utils.Mask)
+	if !ok {
+		return true
+	}
+	for path := range subMask {
+		if path != "Id" {
+			return true
+		}
+	}
+	return false
+}
+
+// projectAccount returns a copy of the account that only contains the fields selected by the mask
+func (s Service) projectAccount(mask fieldmask_utils.Mask, a *proto.Account) (*proto.Account, error) {
+	if mask.IsEmpty() {
+		return a, nil
+	}
+	projected := &proto.Account{}
+	if err := fieldmask_utils.StructToStruct(mask, a, projected); err != nil {
+		return nil, merrors.BadRequest(s.id, "could not apply field mask: %v", err.Error())
+	}
+	return projected, nil
+}
+
 // validateUpdate takes a update field-mask and validates it against a whitelist of updatable paths.
 // Returns a FieldFilter on success which can be passed to the fieldmask_utils..StructToStruct. An error is returned
 // if the mask tries to update no whitelisted fields.
@@ -674,3 +888,20 @@ func (s Service) debugLogAccount(a *proto.Account) *zerolog.Event {
 		"DeletedDateTime":              a.DeletedDateTime,
 	})
 }
+
+// removeSecrets removes the password hashes, the multi-factor secrets and the app password hashes, they are never
+// returned
+func removeSecrets(a *proto.Account) {
+	if a.PasswordProfile != nil {
+		a.PasswordProfile.Password = ""
+		a.PasswordProfile.PasswordHistory = nil
+	}
+	if a.MultiFactorProfile != nil {
+		a.MultiFactorProfile.EncryptedTotpSecret = ""
+		a.MultiFactorProfile.LastTotpStep = 0
+		a.MultiFactorProfile.RecoveryCodeHashes = nil
+	}
+	for _, app := range a.AppPasswords {
+		app.Hash = ""
+	}
+}
@@ -0,0 +1,189 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gofrs/uuid"
+	"github.com/golang/protobuf/ptypes/empty"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	// _maxAppPasswords is the number of app passwords an account can have
+	_maxAppPasswords = 50
+	// _maxAppPasswordNameLength is the maximum number of characters of the name of an app password
+	_maxAppPasswordNameLength = 100
+	// _appPasswordUseInterval is the minimum time between two updates of the last use of an app password, so clients
+	// sending many requests don't rewrite the account all the time
+	_appPasswordUseInterval = time.Minute
+)
+
+// matchAppPassword returns the app password of the account matching the password, or nil
+func matchAppPassword(a *proto.Account, pwd string) *proto.AppPassword {
+	if len(a.AppPasswords) == 0 {
+		return nil
+	}
+	hashes := make([]string, 0, len(a.AppPasswords))
+	for _, app := range a.AppPasswords {
+		hashes = append(hashes, app.Hash)
+	}
+	if i := password.MatchAppPassword(hashes, pwd); i >= 0 {
+		return a.AppPasswords[i]
+	}
+	return nil
+}
+
+// appPasswordAllowed returns true if the app password can be used for the scope. App passwords restricted to scopes
+// can't be used when no scope is requested.
+func appPasswordAllowed(app *proto.AppPassword, scope string) bool {
+	if len(app.Scopes) == 0 {
+		return true
+	}
+	for _, allowed := range app.Scopes {
+		if allowed == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// touchAppPassword records the use of the app password. It returns false if the last use was recorded less than
+// _appPasswordUseInterval ago.
+func touchAppPassword(app *proto.AppPassword, now time.Time) bool {
+	if app.LastUsedDateTime != nil && now.Sub(app.LastUsedDateTime.AsTime()) < _appPasswordUseInterval {
+		return false
+	}
+	app.LastUsedDateTime = timestamppb.New(now)
+	return true
+}
+
+// cleanupScopes trims the scopes and removes duplicates
+func (s Service) cleanupScopes(scopes []string) ([]string, error) {
+	cleaned := make([]string, 0, len(scopes))
+	seen := map[string]struct{}{}
+	for _, scope := range scopes {
+		scope = strings.TrimSpace(scope)
+		if scope == "" {
+			return nil, merrors.BadRequest(s.id, "scopes must not be empty")
+		}
+		if _, ok := seen[scope]; ok {
+			continue
+		}
+		seen[scope] = struct{}{}
+		cleaned = append(cleaned, scope)
+	}
+	if len(cleaned) == 0 {
+		return nil, nil
+	}
+	return cleaned, nil
+}
+
+// withoutHash returns a copy of the app password without the hash
+func withoutHash(app *proto.AppPassword) *proto.AppPassword {
+	c := *app
+	c.Hash = ""
+	return &c
+}
+
+// CreateAppPassword implements the AccountsServiceHandler interface
+func (s Service) CreateAppPassword(ctx context.Context, in *proto.CreateAppPasswordRequest, out *proto.CreateAppPasswordResponse) (err error) {
+	name := strings.TrimSpace(in.Name)
+	if name == "" {
+		return merrors.BadRequest(s.id, "name must not be empty")
+	}
+	if utf8.RuneCountInString(name) > _maxAppPasswordNameLength {
+		return merrors.BadRequest(s.id, "name must not be longer than %d characters", _maxAppPasswordNameLength)
+	}
+	scopes, err := s.cleanupScopes(in.Scopes)
+	if err != nil {
+		return err
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "CreateAppPassword", a); err != nil {
+		return
+	}
+	// app passwords skip TOTP and survive password changes, so users have to prove they are not using a stolen
+	// session. Admins can create them for others.
+	if s.isOwnAccount(ctx, a.Id) {
+		if err = s.reauthenticate(ctx, a, in.Password, in.MfaCode); err != nil {
+			return
+		}
+	}
+	if len(a.AppPasswords) >= _maxAppPasswords {
+		return merrors.BadRequest(s.id, "an account can have at most %d app passwords", _maxAppPasswords)
+	}
+
+	pwd, err := password.GenerateAppPassword()
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not generate app password: %v", err.Error())
+	}
+	app := &proto.AppPassword{
+		Id:              uuid.Must(uuid.NewV4()).String(),
+		Name:            name,
+		Scopes:          scopes,
+		CreatedDateTime: timestamppb.Now(),
+		Hash:            password.HashAppPassword(pwd),
+	}
+	a.AppPasswords = append(a.AppPasswords, app)
+	if err = s.persistAccount(a); err != nil {
+		return
+	}
+	s.log.Info().Str("id", a.Id).Str("app_password", app.Id).Strs("scopes", scopes).Msg("created app password")
+
+	out.AppPassword = withoutHash(app)
+	out.Password = pwd
+	return nil
+}
+
+// ListAppPasswords implements the AccountsServiceHandler interface
+func (s Service) ListAppPasswords(ctx context.Context, in *proto.ListAppPasswordsRequest, out *proto.ListAppPasswordsResponse) (err error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "ListAppPasswords", a); err != nil {
+		return
+	}
+	out.AppPasswords = make([]*proto.AppPassword, 0, len(a.AppPasswords))
+	for _, app := range a.AppPasswords {
+		out.AppPasswords = append(out.AppPasswords, withoutHash(app))
+	}
+	return nil
+}
+
+// RevokeAppPassword implements the AccountsServiceHandler interface
+func (s Service) RevokeAppPassword(ctx context.Context, in *proto.RevokeAppPasswordRequest, out *empty.Empty) (err error) {
+	if in.Id == "" {
+		return merrors.BadRequest(s.id, "app password id missing")
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "RevokeAppPassword", a); err != nil {
+		return
+	}
+	for i, app := range a.AppPasswords {
+		if app.Id != in.Id {
+			continue
+		}
+		a.AppPasswords = append(a.AppPasswords[:i], a.AppPasswords[i+1:]...)
+		if err = s.persistAccount(a); err != nil {
+			return
+		}
+		s.log.Info().Str("id", a.Id).Str("app_password", in.Id).Msg("revoked app password")
+		return nil
+	}
+	return merrors.NotFound(s.id, "app password not found: %v", in.Id)
+}
@@ -0,0 +1,106 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/mfa"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAppPasswords(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	ctx := context.Background()
+	authenticate := func(password, scope string) *proto.AuthenticateAccountResponse {
+		out := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(ctx, &proto.AuthenticateAccountRequest{Login: "einstein", Password: password, Scope: scope}, out))
+		return out
+	}
+
+	assert.Error(t, svc.CreateAppPassword(ctx, &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: " "}, &proto.CreateAppPasswordResponse{}))
+	assert.Error(t, svc.CreateAppPassword(ctx, &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: "phone", Scopes: []string{""}}, &proto.CreateAppPasswordResponse{}))
+
+	webdav := &proto.CreateAppPasswordResponse{}
+	assert.NoError(t, svc.CreateAppPassword(ctx, &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: "phone", Scopes: []string{"webdav", " webdav"}}, webdav))
+	assert.NotEmpty(t, webdav.Password)
+	assert.Empty(t, webdav.AppPassword.Hash)
+	assert.Equal(t, []string{"webdav"}, webdav.AppPassword.Scopes)
+
+	raw, err := ioutil.ReadFile(filepath.Join(svc.Config.Server.AccountsDataPath, "accounts", "einstein"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), webdav.Password, "app passwords are stored hashed")
+
+	out := authenticate(webdav.Password, "webdav")
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, out.Failure)
+	assert.Equal(t, webdav.AppPassword.Id, out.AppPassword.Id)
+	assert.Empty(t, out.AppPassword.Hash)
+	assert.NotNil(t, out.AppPassword.LastUsedDateTime)
+	assert.Equal(t, proto.AuthenticationFailure_INSUFFICIENT_SCOPE, authenticate(webdav.Password, "ocs").Failure)
+	assert.Equal(t, proto.AuthenticationFailure_INSUFFICIENT_SCOPE, authenticate(webdav.Password, "").Failure)
+	assert.Nil(t, authenticate("relativity", "webdav").AppPassword, "the password of the account still works")
+
+	unrestricted := &proto.CreateAppPasswordResponse{}
+	assert.NoError(t, svc.CreateAppPassword(ctx, &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: "desktop"}, unrestricted))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(unrestricted.Password, "ocs").Failure)
+
+	list := &proto.ListAppPasswordsResponse{}
+	assert.NoError(t, svc.ListAppPasswords(ctx, &proto.ListAppPasswordsRequest{AccountId: "einstein"}, list))
+	assert.Len(t, list.AppPasswords, 2)
+	for _, app := range list.AppPasswords {
+		assert.Empty(t, app.Hash)
+	}
+
+	changed := &proto.AuthenticateAccountResponse{}
+	assert.NoError(t, svc.ChangePassword(ctx, &proto.ChangePasswordRequest{Login: "einstein", CurrentPassword: unrestricted.Password, NewPassword: "E=mc²-1905-annus"}, changed))
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_PASSWORD, changed.Failure, "app passwords cannot change the password")
+
+	assert.NoError(t, svc.RevokeAppPassword(ctx, &proto.RevokeAppPasswordRequest{AccountId: "einstein", Id: webdav.AppPassword.Id}, nil))
+	assert.Error(t, svc.RevokeAppPassword(ctx, &proto.RevokeAppPasswordRequest{AccountId: "einstein", Id: webdav.AppPassword.Id}, nil))
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_PASSWORD, authenticate(webdav.Password, "webdav").Failure)
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(unrestricted.Password, "").Failure)
+}
+
+func TestAppPasswordOwnAccount(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	var err error
+	svc.secrets, err = mfa.NewCipher([]byte("test key"))
+	assert.NoError(t, err)
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, "einstein")
+	create := func(password, code string) error {
+		return svc.CreateAppPassword(ctx, &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: "phone", Password: password, MfaCode: code}, &proto.CreateAppPasswordResponse{})
+	}
+
+	assert.Error(t, create("", ""), "a stolen session is not enough")
+	assert.Error(t, create("quantum", ""))
+	assert.NoError(t, create("relativity", ""))
+
+	// admins enroll TOTP for the user
+	enrollment := &proto.EnrollTotpResponse{}
+	assert.NoError(t, svc.EnrollTotp(context.Background(), &proto.EnrollTotpRequest{AccountId: "einstein"}, enrollment))
+	secret, err := mfa.DecodeSecret(enrollment.Secret)
+	assert.NoError(t, err)
+	now := time.Now()
+	assert.NoError(t, svc.VerifyTotp(context.Background(), &proto.VerifyTotpRequest{AccountId: "einstein", Code: mfa.Code(secret, mfa.Step(now))}, &proto.VerifyTotpResponse{}))
+
+	assert.Error(t, create("relativity", ""), "app passwords do not skip the second factor")
+	assert.NoError(t, create("relativity", mfa.Code(secret, mfa.Step(now)+1)))
+
+	// admins can create app passwords for others without their credentials
+	assert.NoError(t, svc.CreateAppPassword(context.Background(), &proto.CreateAppPasswordRequest{AccountId: "einstein", Name: "desktop"}, &proto.CreateAppPasswordResponse{}))
+
+	list := &proto.ListAppPasswordsResponse{}
+	assert.NoError(t, svc.ListAppPasswords(ctx, &proto.ListAppPasswordsRequest{AccountId: "einstein"}, list))
+	assert.Len(t, list.AppPasswords, 3)
+}
@@ -0,0 +1,358 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// defaultLoginAttributes are used when the config does not set the login attributes
+var defaultLoginAttributes = []string{"on_premises_sam_account_name", "mail"}
+
+// loginAttributes maps the attributes that can be used to log in to their value in an account
+var loginAttributes = map[string]func(a *proto.Account) string{
+	"id":                              func(a *proto.Account) string { return a.Id },
+	"on_premises_sam_account_name":    func(a *proto.Account) string { return a.OnPremisesSamAccountName },
+	"preferred_name":                  func(a *proto.Account) string { return a.PreferredName },
+	"mail":                            func(a *proto.Account) string { return a.Mail },
+	"on_premises_user_principal_name": func(a *proto.Account) string { return a.OnPremisesUserPrincipalName },
+	"on_premises_immutable_id":        func(a *proto.Account) string { return a.OnPremisesImmutableId },
+}
+
+// _maxLoginCandidates is the number of accounts checked for an exact match of the login
+const _maxLoginCandidates = 10
+
+func (s Service) loginAttributes() []string {
+	if s.Config.Auth.LoginAttributes == "" {
+		return defaultLoginAttributes
+	}
+	attributes := []string{}
+	for _, attribute := range strings.Split(s.Config.Auth.LoginAttributes, ",") {
+		attribute = strings.TrimSpace(attribute)
+		if _, ok := loginAttributes[attribute]; !ok {
+			s.log.Error().Str("attribute", attribute).Msg("unknown login attribute, skipping")
+			continue
+		}
+		attributes = append(attributes, attribute)
+	}
+	return attributes
+}
+
+// findAccountByLogin loads the account with the given login from disk, including the password hash. It returns nil
+// when no account or more than one account has the login.
+func (s Service) findAccountByLogin(ctx context.Context, login string) (*proto.Account, error) {
+	attributes := s.loginAttributes()
+	if len(attributes) == 0 {
+		return nil, merrors.InternalServerError(s.id, "no login attributes configured")
+	}
+
+	// only search for accounts
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+
+	// the login is never parsed as a query, so it needs no escaping
+	lq := bleve.NewDisjunctionQuery()
+	for _, attribute := range attributes {
+		mq := bleve.NewMatchQuery(login)
+		mq.SetField(attribute)
+		mq.SetOperator(query.MatchQueryOperatorAnd)
+		lq.AddQuery(mq)
+	}
+
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(tq, lq))
+	searchRequest.Size = _maxLoginCandidates
+	searchResult, err := s.search(ctx, searchRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	// the match queries also find values that only contain the login, so compare the whole values
+	var found *proto.Account
+	for _, hit := range searchResult.Hits {
+		a := &proto.Account{}
+		if err := s.loadAccount(hit.ID, a); err != nil {
+			s.log.Error().Err(err).Str("account", hit.ID).Msg("could not load account, skipping")
+			continue
+		}
+		for _, attribute := range attributes {
+			if !strings.EqualFold(loginAttributes[attribute](a), login) {
+				continue
+			}
+			if found != nil {
+				s.log.Error().Str("account", found.Id).Str("other", a.Id).Msg("login is ambiguous")
+				return nil, nil
+			}
+			found = a
+			break
+		}
+	}
+	return found, nil
+}
+
+// credentials are checked by authenticate
+type credentials struct {
+	login    string
+	password string
+	// mfaCode is a TOTP or recovery code, required if the account enrolled TOTP
+	mfaCode string
+	// scope is the scope the authentication is requested for, app passwords restricted to other scopes are rejected
+	scope string
+	// appPasswords allows to authenticate with an app password instead of the password of the account
+	appPasswords bool
+}
+
+// authenticate checks the password of the account with the given login and, if the account enrolled TOTP, the TOTP or
+// recovery code. If allowed, app passwords of the account are accepted instead, they need no second factor and do not
+// expire. The account is only returned when the authentication succeeded or when only the password has to be
+// changed, the app password only when it was used. Failed attempts are counted per account and per client address,
+// locked accounts and addresses fail without checking the password. Whether an account is disabled or its password
+// has expired is only checked after the password matched, so only users that know the password learn it.
+func (s Service) authenticate(ctx context.Context, c credentials) (*proto.Account, *proto.AppPassword, proto.AuthenticationFailure, error) {
+	now := time.Now()
+	source := s.clientAddress(ctx)
+	if s.sourceLocked(source, now) {
+		return nil, nil, proto.AuthenticationFailure_SOURCE_LOCKED, nil
+	}
+
+	a, err := s.findAccountByLogin(ctx, c.login)
+	if err != nil {
+		return nil, nil, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, err
+	}
+	if a == nil {
+		// take as long as for known accounts
+		s.passwordHashers().VerifyUnknown(c.password)
+		s.recordFailedSource(source, now)
+		return nil, nil, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, nil
+	}
+
+	app, failure := s.verifyCredentials(a, c, source, now)
+	if !passwordVerified(failure) {
+		return nil, nil, failure, nil
+	}
+	return a, app, failure, nil
+}
+
+// passwordVerified returns true if the failure is only about the password having to be changed, the credentials
+// were valid then
+func passwordVerified(failure proto.AuthenticationFailure) bool {
+	switch failure {
+	case proto.AuthenticationFailure_NO_FAILURE,
+		proto.AuthenticationFailure_PASSWORD_CHANGE_REQUIRED,
+		proto.AuthenticationFailure_PASSWORD_EXPIRED:
+		return true
+	}
+	return false
+}
+
+// verifyCredentials checks the credentials against the loaded account, see authenticate. The login of the credentials
+// is ignored. It returns the app password if one was used.
+func (s Service) verifyCredentials(a *proto.Account, c credentials, source string, now time.Time) (*proto.AppPassword, proto.AuthenticationFailure) {
+	if accountLocked(a, now) {
+		return nil, proto.AuthenticationFailure_ACCOUNT_LOCKED
+	}
+
+	ok, rehash := false, false
+	if hash := a.GetPasswordProfile().GetPassword(); hash != "" {
+		ok, rehash = s.passwordIsValid(hash, c.password)
+	} else {
+		s.debugLogAccount(a).Msg("no password profile")
+		s.passwordHashers().VerifyUnknown(c.password)
+	}
+	var app *proto.AppPassword
+	if !ok && c.appPasswords {
+		app = matchAppPassword(a, c.password)
+	}
+	if !ok && app == nil {
+		s.recordFailedSource(source, now)
+		s.recordFailedSignIn(a, now)
+		s.saveAccount(a)
+		return nil, proto.AuthenticationFailure_INVALID_PASSWORD
+	}
+
+	// check the second factor before resetting the failed attempts, so codes cannot be guessed with the password
+	changed := false
+	if app == nil && totpEnabled(a) {
+		if c.mfaCode == "" {
+			return nil, proto.AuthenticationFailure_MFA_REQUIRED
+		}
+		if !s.verifySecondFactor(a, c.mfaCode, now) {
+			s.recordFailedSource(source, now)
+			s.recordFailedSignIn(a, now)
+			s.saveAccount(a)
+			return nil, proto.AuthenticationFailure_INVALID_MFA_CODE
+		}
+		// the used code must not be accepted again
+		changed = true
+	}
+
+	if resetFailedSignIns(a) {
+		changed = true
+	}
+	if rehash && s.rehashPassword(a, c.password) {
+		changed = true
+	}
+	if app != nil && appPasswordAllowed(app, c.scope) && touchAppPassword(app, now) {
+		changed = true
+	}
+	if changed {
+		s.saveAccount(a)
+	}
+
+	if !a.AccountEnabled {
+		return nil, proto.AuthenticationFailure_ACCOUNT_DISABLED
+	}
+	if app != nil {
+		if !appPasswordAllowed(app, c.scope) {
+			return nil, proto.AuthenticationFailure_INSUFFICIENT_SCOPE
+		}
+		return app, proto.AuthenticationFailure_NO_FAILURE
+	}
+	if a.PasswordProfile.ForceChangePasswordNextSignIn || a.PasswordProfile.ForceChangePasswordNextSignInWithMfa {
+		return nil, proto.AuthenticationFailure_PASSWORD_CHANGE_REQUIRED
+	}
+	if s.passwordExpired(a, now) {
+		return nil, proto.AuthenticationFailure_PASSWORD_EXPIRED
+	}
+	return nil, proto.AuthenticationFailure_NO_FAILURE
+}
+
+// reauthenticate checks the current password and, if the account enabled TOTP, the TOTP or recovery code before users
+// change the security settings of their own account, so a stolen session is not enough for it. The checks and the
+// counting of failed attempts are the same as in authenticate, but app passwords are not accepted.
+func (s Service) reauthenticate(ctx context.Context, a *proto.Account, password string, mfaCode string) error {
+	if password == "" {
+		return merrors.Forbidden(s.id, "the current password is required")
+	}
+	now := time.Now()
+	source := s.clientAddress(ctx)
+	if s.sourceLocked(source, now) {
+		return merrors.Forbidden(s.id, "too many failed attempts, try again later")
+	}
+	_, failure := s.verifyCredentials(a, credentials{password: password, mfaCode: mfaCode}, source, now)
+	switch failure {
+	case proto.AuthenticationFailure_ACCOUNT_LOCKED:
+		return merrors.Forbidden(s.id, "account is locked")
+	case proto.AuthenticationFailure_INVALID_PASSWORD:
+		return merrors.Forbidden(s.id, "current password is invalid")
+	case proto.AuthenticationFailure_MFA_REQUIRED, proto.AuthenticationFailure_INVALID_MFA_CODE:
+		return merrors.Forbidden(s.id, "a valid TOTP or recovery code is required")
+	case proto.AuthenticationFailure_ACCOUNT_DISABLED:
+		return merrors.Forbidden(s.id, "account is disabled")
+	}
+	return nil
+}
+
+// rehashPassword replaces the password hash of the account with a hash of the configured algorithm and cost. It
+// returns false if the password could not be hashed, the old hash still works then.
+func (s Service) rehashPassword(a *proto.Account, password string) bool {
+	hash, err := s.passwordHashers().Hash(password)
+	if err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not rehash password")
+		return false
+	}
+	a.PasswordProfile.Password = hash
+	s.log.Info().Str("id", a.Id).Msg("rehashed password")
+	return true
+}
+
+// saveAccount persists and indexes an account changed during authentication. Errors are only logged, they must not
+// change the outcome of the authentication.
+func (s Service) saveAccount(a *proto.Account) {
+	if err := s.writeAccount(a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist account")
+		return
+	}
+	if err := s.indexAccount(a.Id); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not index account")
+	}
+}
+
+// AuthenticateAccount implements the AccountsServiceHandler interface
+func (s Service) AuthenticateAccount(ctx context.Context, in *proto.AuthenticateAccountRequest, out *proto.AuthenticateAccountResponse) (err error) {
+	if in.Login == "" {
+		return merrors.BadRequest(s.id, "login must not be empty")
+	}
+	if in.Password == "" {
+		return merrors.BadRequest(s.id, "password must not be empty")
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a, app, failure, err := s.authenticate(ctx, credentials{
+		login:        in.Login,
+		password:     in.Password,
+		mfaCode:      in.MfaCode,
+		scope:        in.Scope,
+		appPasswords: true,
+	})
+	if err != nil {
+		return err
+	}
+	out.Failure = failure
+	if failure != proto.AuthenticationFailure_NO_FAILURE {
+		s.log.Debug().Str("login", in.Login).Str("failure", failure.String()).Msg("authentication failed")
+		return nil
+	}
+
+	s.expandMemberOf(a)
+
+	// remove passwords
+	removeSecrets(a)
+
+	out.Account = a
+	if app != nil {
+		out.AppPassword = withoutHash(app)
+	}
+	return nil
+}
+
+// listAuthenticatedAccount answers auth requests sent as a ListAccounts query, see authQuery. The list is empty when
+// no account has the login.
+func (s Service) listAuthenticatedAccount(ctx context.Context, login string, password string, fieldMask *field_mask.FieldMask, out *proto.ListAccountsResponse) error {
+	if password == "" {
+		return merrors.Unauthorized(s.id, "password must not be empty")
+	}
+
+	mask, err := s.readMask(fieldMask)
+	if err != nil {
+		return err
+	}
+
+	s.log.Debug().Str("login", login).Msg("authenticating with a ListAccounts query, use AuthenticateAccount instead")
+	// there is no way to send a second factor or a scope, accounts with TOTP have to use AuthenticateAccount or an
+	// unrestricted app password
+	a, _, failure, err := s.authenticate(ctx, credentials{login: login, password: password, appPasswords: true})
+	if err != nil {
+		return err
+	}
+
+	out.Accounts = make([]*proto.Account, 0)
+	switch failure {
+	case proto.AuthenticationFailure_NO_FAILURE:
+	case proto.AuthenticationFailure_UNKNOWN_ACCOUNT:
+		return nil
+	default:
+		s.log.Debug().Str("login", login).Str("failure", failure.String()).Msg("authentication failed")
+		return merrors.Unauthorized(s.id, "invalid password")
+	}
+
+	if expansionRequested(mask, "MemberOf") {
+		s.expandMemberOf(a)
+	}
+
+	// remove passwords before returning
+	removeSecrets(a)
+
+	if a, err = s.projectAccount(mask, a); err != nil {
+		return err
+	}
+	out.Accounts = append(out.Accounts, a)
+	return nil
+}
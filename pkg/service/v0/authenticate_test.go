@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthenticateAccount(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	a := einstein()
+	a.Mail = "einstein@example.org"
+	addTestAccount(t, svc, a, "relativity")
+	addTestAccount(t, svc, &proto.Account{Id: "marie", AccountEnabled: false, OnPremisesSamAccountName: "marie", Mail: "marie@example.org"}, "relativity")
+	addTestAccount(t, svc, &proto.Account{Id: "feynman", AccountEnabled: true, OnPremisesSamAccountName: "feynman"}, "")
+
+	var scenarios = []struct {
+		name     string
+		login    string
+		password string
+		failure  proto.AuthenticationFailure
+	}{
+		{"username", "einstein", "relativity", proto.AuthenticationFailure_NO_FAILURE},
+		{"mail", "einstein@example.org", "relativity", proto.AuthenticationFailure_NO_FAILURE},
+		{"logins are not case sensitive", "Einstein", "relativity", proto.AuthenticationFailure_NO_FAILURE},
+		{"wrong password", "einstein", "quantum", proto.AuthenticationFailure_INVALID_PASSWORD},
+		{"passwords with quotes", "einstein", "rel'ativity", proto.AuthenticationFailure_INVALID_PASSWORD},
+		{"unknown login", "bohr", "relativity", proto.AuthenticationFailure_UNKNOWN_ACCOUNT},
+		{"partial login", "einstein@example", "relativity", proto.AuthenticationFailure_UNKNOWN_ACCOUNT},
+		{"disabled account", "marie", "relativity", proto.AuthenticationFailure_ACCOUNT_DISABLED},
+		{"disabled account with wrong password", "marie", "quantum", proto.AuthenticationFailure_INVALID_PASSWORD},
+		{"account without password", "feynman", "relativity", proto.AuthenticationFailure_INVALID_PASSWORD},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			out := &proto.AuthenticateAccountResponse{}
+			assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: scenario.login, Password: scenario.password}, out))
+			assert.Equal(t, scenario.failure, out.Failure)
+			if scenario.failure == proto.AuthenticationFailure_NO_FAILURE {
+				if assert.NotNil(t, out.Account) {
+					assert.Equal(t, "einstein", out.Account.Id)
+					assert.Empty(t, out.Account.PasswordProfile.Password)
+				}
+			} else {
+				assert.Nil(t, out.Account)
+			}
+		})
+	}
+
+	// the auth query of ListAccounts is answered the same way
+	out := &proto.ListAccountsResponse{}
+	assert.NoError(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{Query: "login eq 'einstein' and password eq 'relativity'"}, out))
+	if assert.Len(t, out.Accounts, 1) {
+		assert.Empty(t, out.Accounts[0].PasswordProfile.Password)
+	}
+	assert.Error(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{Query: "login eq 'einstein' and password eq 'quantum'"}, &proto.ListAccountsResponse{}))
+}
+
+func TestRehashPasswordOnLogin(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	// a salted SHA-1 hash of "secret", as migrated from an LDAP server
+	addTestAccount(t, svc, &proto.Account{Id: "curie", AccountEnabled: true, OnPremisesSamAccountName: "curie", PasswordProfile: &proto.PasswordProfile{Password: "{SSHA}1G904nLkTkGWjKNnQuB/hpWXC/hzYWx0c2FsdA=="}}, "")
+
+	out := &proto.AuthenticateAccountResponse{}
+	assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: "curie", Password: "secret"}, out))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, out.Failure)
+
+	stored := &proto.Account{}
+	assert.NoError(t, svc.loadAccount("curie", stored))
+	cost, err := bcrypt.Cost([]byte(stored.PasswordProfile.Password))
+	assert.NoError(t, err, "the hash is replaced with a bcrypt hash")
+	assert.Equal(t, 12, cost)
+
+	out = &proto.AuthenticateAccountResponse{}
+	assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: "curie", Password: "secret"}, out))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, out.Failure)
+}
@@ -0,0 +1,118 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// passwordExpired returns true if the password of the account is older than the configured maximum password age.
+// Accounts with the DisablePasswordExpiration policy never expire, accounts that never changed their password use
+// the creation date.
+func (s Service) passwordExpired(a *proto.Account, now time.Time) bool {
+	maxAge := s.Config.Auth.MaxPasswordAge
+	if maxAge <= 0 || hasPolicy(a.PasswordProfile, policyDisablePasswordExpiration) {
+		return false
+	}
+	changed := a.GetPasswordProfile().GetLastPasswordChangeDateTime()
+	if changed == nil {
+		changed = a.CreatedDateTime
+	}
+	if changed == nil {
+		return false
+	}
+	return now.After(changed.AsTime().Add(maxAge))
+}
+
+// setPassword checks a password chosen by the user against the password policy and the history and replaces the
+// password hash of the account. Hashes of the history in checked were already compared, see checkPasswordReuse. The
+// user has changed the password, so it no longer has to be changed on the next sign-in. Existing sessions are revoked.
+func (s Service) setPassword(a *proto.Account, pwd string, now time.Time, checked []string) error {
+	if a.PasswordProfile == nil {
+		a.PasswordProfile = &proto.PasswordProfile{}
+	}
+	if err := s.checkPasswordPolicy(a, pwd); err != nil {
+		return err
+	}
+	if _, err := s.checkPasswordReuse(a.PasswordProfile.Password, a.PasswordProfile.PasswordHistory, pwd, checked); err != nil {
+		return err
+	}
+	hash, err := s.passwordHashers().Hash(pwd)
+	if err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not hash password")
+		return merrors.InternalServerError(s.id, "could not hash password: %v", err.Error())
+	}
+	a.PasswordProfile.PasswordHistory = s.appendPasswordHistory(a.PasswordProfile.PasswordHistory, a.PasswordProfile.Password)
+	a.PasswordProfile.Password = hash
+	a.PasswordProfile.LastPasswordChangeDateTime = timestamppb.New(now)
+	a.PasswordProfile.ForceChangePasswordNextSignIn = false
+	a.PasswordProfile.ForceChangePasswordNextSignInWithMfa = false
+	revokeSessions(a, now)
+	return nil
+}
+
+// ChangePassword implements the AccountsServiceHandler interface
+func (s Service) ChangePassword(ctx context.Context, in *proto.ChangePasswordRequest, out *proto.AuthenticateAccountResponse) (err error) {
+	if in.Login == "" {
+		return merrors.BadRequest(s.id, "login must not be empty")
+	}
+	if in.CurrentPassword == "" {
+		return merrors.BadRequest(s.id, "current password must not be empty")
+	}
+	if in.NewPassword == "" {
+		return merrors.BadRequest(s.id, "new password must not be empty")
+	}
+
+	// app passwords cannot be used to change the password of the account
+	accLock.Lock()
+	a, _, failure, err := s.authenticate(ctx, credentials{login: in.Login, password: in.CurrentPassword, mfaCode: in.MfaCode})
+	accLock.Unlock()
+	if err != nil {
+		return err
+	}
+	switch failure {
+	case proto.AuthenticationFailure_NO_FAILURE, proto.AuthenticationFailure_PASSWORD_EXPIRED, proto.AuthenticationFailure_PASSWORD_CHANGE_REQUIRED:
+	default:
+		s.log.Debug().Str("login", in.Login).Str("failure", failure.String()).Msg("authentication for password change failed")
+		out.Failure = failure
+		return nil
+	}
+
+	// comparing the new password with the history is slow, it must not block other requests
+	if err = s.checkPasswordPolicy(a, in.NewPassword); err != nil {
+		return err
+	}
+	checked, err := s.checkPasswordReuse(a.GetPasswordProfile().GetPassword(), a.GetPasswordProfile().GetPasswordHistory(), in.NewPassword, nil)
+	if err != nil {
+		return err
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	if err = s.reloadForPasswordChange(a); err != nil {
+		return err
+	}
+	if err = s.setPassword(a, in.NewPassword, time.Now(), checked); err != nil {
+		return err
+	}
+	if err = s.writeAccount(a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not persist changed password")
+		return merrors.InternalServerError(s.id, "could not persist changed password: %v", err.Error())
+	}
+	if err = s.indexAccount(a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	s.log.Info().Str("id", a.Id).Msg("changed password")
+
+	s.expandMemberOf(a)
+
+	// remove passwords
+	removeSecrets(a)
+
+	out.Failure = proto.AuthenticationFailure_NO_FAILURE
+	out.Account = a
+	return nil
+}
@@ -0,0 +1,92 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestPasswordExpiration(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.MaxPasswordAge = 24 * time.Hour
+
+	old := timestamppb.New(time.Now().Add(-48 * time.Hour))
+	for _, a := range []*proto.Account{
+		{Id: "einstein", AccountEnabled: true, OnPremisesSamAccountName: "einstein", PasswordProfile: &proto.PasswordProfile{LastPasswordChangeDateTime: timestamppb.Now()}},
+		{Id: "marie", AccountEnabled: true, OnPremisesSamAccountName: "marie", PasswordProfile: &proto.PasswordProfile{LastPasswordChangeDateTime: old}},
+		{Id: "feynman", AccountEnabled: true, OnPremisesSamAccountName: "feynman", CreatedDateTime: old},
+		{Id: "bohr", AccountEnabled: true, OnPremisesSamAccountName: "bohr", PasswordProfile: &proto.PasswordProfile{LastPasswordChangeDateTime: old, PasswordPolicies: []string{policyDisablePasswordExpiration}}},
+		{Id: "curie", AccountEnabled: true, OnPremisesSamAccountName: "curie", PasswordProfile: &proto.PasswordProfile{ForceChangePasswordNextSignIn: true}},
+		{Id: "planck", AccountEnabled: false, OnPremisesSamAccountName: "planck", PasswordProfile: &proto.PasswordProfile{ForceChangePasswordNextSignIn: true}},
+	} {
+		addTestAccount(t, svc, a, "relativity")
+	}
+
+	var scenarios = []struct {
+		name    string
+		login   string
+		failure proto.AuthenticationFailure
+	}{
+		{"recently changed password", "einstein", proto.AuthenticationFailure_NO_FAILURE},
+		{"expired password", "marie", proto.AuthenticationFailure_PASSWORD_EXPIRED},
+		{"account created before the maximum age", "feynman", proto.AuthenticationFailure_PASSWORD_EXPIRED},
+		{"expiration disabled by the password policy", "bohr", proto.AuthenticationFailure_NO_FAILURE},
+		{"password change forced", "curie", proto.AuthenticationFailure_PASSWORD_CHANGE_REQUIRED},
+		{"disabled account", "planck", proto.AuthenticationFailure_ACCOUNT_DISABLED},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			out := &proto.AuthenticateAccountResponse{}
+			assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: scenario.login, Password: "relativity"}, out))
+			assert.Equal(t, scenario.failure, out.Failure)
+			assert.Equal(t, scenario.failure == proto.AuthenticationFailure_NO_FAILURE, out.Account != nil)
+		})
+	}
+}
+
+func TestChangePassword(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.policy = &password.Policy{MinLength: 8}
+
+	addTestAccount(t, svc, &proto.Account{Id: "curie", AccountEnabled: true, OnPremisesSamAccountName: "curie", PasswordProfile: &proto.PasswordProfile{ForceChangePasswordNextSignIn: true}}, "relativity")
+
+	changePassword := func(current, new string) (*proto.AuthenticateAccountResponse, error) {
+		out := &proto.AuthenticateAccountResponse{}
+		err := svc.ChangePassword(context.Background(), &proto.ChangePasswordRequest{Login: "curie", CurrentPassword: current, NewPassword: new}, out)
+		return out, err
+	}
+
+	out, err := changePassword("quantum", "radioactivity")
+	assert.NoError(t, err)
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_PASSWORD, out.Failure)
+	assert.Nil(t, out.Account)
+
+	_, err = changePassword("relativity", "radium")
+	assert.Error(t, err, "the new password has to meet the password policy")
+
+	out, err = changePassword("relativity", "radioactivity")
+	assert.NoError(t, err)
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, out.Failure)
+	if assert.NotNil(t, out.Account) {
+		assert.Empty(t, out.Account.PasswordProfile.Password)
+		assert.False(t, out.Account.PasswordProfile.ForceChangePasswordNextSignIn)
+		assert.NotNil(t, out.Account.PasswordProfile.LastPasswordChangeDateTime)
+	}
+
+	for password, failure := range map[string]proto.AuthenticationFailure{
+		"relativity":    proto.AuthenticationFailure_INVALID_PASSWORD,
+		"radioactivity": proto.AuthenticationFailure_NO_FAILURE,
+	} {
+		auth := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: "curie", Password: password}, auth))
+		assert.Equal(t, failure, auth.Failure, password)
+	}
+}
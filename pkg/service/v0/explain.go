@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// _defaultExplainPageSize is used when an ExplainQueryRequest does not specify a page size
+const _defaultExplainPageSize = 10
+
+// ExplainQuery implements the AccountsServiceHandler interface
+func (s Service) ExplainQuery(ctx context.Context, in *proto.ExplainQueryRequest, out *proto.ExplainQueryResponse) (err error) {
+	if !s.hasAccountManagementPermissions(ctx) {
+		return merrors.Forbidden(s.id, "no permission for ExplainQuery")
+	}
+	if strings.TrimSpace(in.Query) == "" {
+		return merrors.BadRequest(s.id, "query must not be empty")
+	}
+
+	recordType, scimAttributes, err := s.recordType(in.Type)
+	if err != nil {
+		return err
+	}
+
+	var size int
+	if in.PageSize == 0 {
+		size = _defaultExplainPageSize
+	} else if size, err = s.pageSize(in.PageSize); err != nil {
+		return err
+	}
+
+	fq, err := s.buildFilterQuery(in.FilterLanguage, in.Query, scimAttributes)
+	if err != nil {
+		return err
+	}
+	if err = s.checkQueryLimits(fq); err != nil {
+		return err
+	}
+
+	if in.FilterLanguage == "" || strings.EqualFold(in.FilterLanguage, filterLanguageOData) {
+		// buildFilterQuery already succeeded, so the query can be parsed
+		if tree, err := godata.ParseFilterString(in.Query); err == nil {
+			var b strings.Builder
+			writeParseTree(&b, tree.Tree, 0)
+			out.ParseTree = b.String()
+		}
+	}
+
+	bq, err := json.Marshal(fq)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not marshal bleve query: %v", err.Error())
+	}
+	out.BleveQuery = string(bq)
+
+	out.AnalyzedValues = []*proto.AnalyzedValue{}
+	s.analyzeValues(fq, out)
+
+	// only search for records of the requested type
+	tq := bleve.NewTermQuery(recordType)
+	tq.SetField("bleve_type")
+
+	searchRequest := bleve.NewSearchRequest(bleve.NewConjunctionQuery(tq, fq))
+	searchRequest.Size = size
+	searchRequest.Explain = true
+	searchResult, err := s.search(ctx, searchRequest)
+	if err != nil {
+		return err
+	}
+
+	out.Hits = make([]*proto.ExplainedHit, 0, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		h := &proto.ExplainedHit{
+			Id:    hit.ID,
+			Score: hit.Score,
+		}
+		if hit.Expl != nil {
+			h.Explanation = hit.Expl.String()
+		}
+		out.Hits = append(out.Hits, h)
+	}
+	return nil
+}
+
+// writeParseTree writes one line per node of an odata parse tree, indented by the depth of the node
+func writeParseTree(b *strings.Builder, n *godata.ParseNode, depth int) {
+	if n == nil {
+		return
+	}
+	b.WriteString(strings.Repeat("  ", depth))
+	b.WriteString(n.Token.Value)
+	b.WriteString("\n")
+	for _, child := range n.Children {
+		writeParseTree(b, child, depth+1)
+	}
+}
+
+// analyzeValues adds the literal values of the query q and the tokens they are analyzed into to the response.
+// Term, prefix and wildcard queries are not analyzed, their values are used as is.
+func (s Service) analyzeValues(q query.Query, out *proto.ExplainQueryResponse) {
+	switch q := q.(type) {
+	case *query.ConjunctionQuery:
+		for _, sub := range q.Conjuncts {
+			s.analyzeValues(sub, out)
+		}
+	case *query.DisjunctionQuery:
+		for _, sub := range q.Disjuncts {
+			s.analyzeValues(sub, out)
+		}
+	case *query.BooleanQuery:
+		for _, sub := range []query.Query{q.Must, q.Should, q.MustNot} {
+			if sub != nil {
+				s.analyzeValues(sub, out)
+			}
+		}
+	case *query.MatchQuery:
+		out.AnalyzedValues = append(out.AnalyzedValues, s.analyzeValue(q.FieldVal, q.Match, q.Analyzer))
+	case *query.MatchPhraseQuery:
+		out.AnalyzedValues = append(out.AnalyzedValues, s.analyzeValue(q.FieldVal, q.MatchPhrase, q.Analyzer))
+	case *query.TermQuery:
+		out.AnalyzedValues = append(out.AnalyzedValues, &proto.AnalyzedValue{Field: q.FieldVal, Value: q.Term, Tokens: []string{q.Term}})
+	case *query.PrefixQuery:
+		out.AnalyzedValues = append(out.AnalyzedValues, &proto.AnalyzedValue{Field: q.FieldVal, Value: q.Prefix, Tokens: []string{q.Prefix}})
+	case *query.WildcardQuery:
+		out.AnalyzedValues = append(out.AnalyzedValues, &proto.AnalyzedValue{Field: q.FieldVal, Value: q.Wildcard, Tokens: []string{q.Wildcard}})
+	}
+}
+
+// analyzeValue runs the analyzer bleve uses for a match query on value
+func (s Service) analyzeValue(field string, value string, analyzerName string) *proto.AnalyzedValue {
+	m := s.index.Mapping()
+	if field == "" {
+		field = m.DefaultSearchField()
+	}
+	if analyzerName == "" {
+		analyzerName = m.AnalyzerNameForPath(field)
+	}
+	av := &proto.AnalyzedValue{
+		Field:    field,
+		Value:    value,
+		Analyzer: analyzerName,
+		Tokens:   []string{},
+	}
+	if analyzer := m.AnalyzerNamed(analyzerName); analyzer != nil {
+		for _, token := range analyzer.Analyze([]byte(value)) {
+			av.Tokens = append(av.Tokens, string(token.Term))
+		}
+	}
+	return av
+}
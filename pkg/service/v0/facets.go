@@ -0,0 +1,84 @@
+package service
+
+import (
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// _facetSize is the maximum number of terms returned per facet
+const _facetSize = 100
+
+// accountFacetFields maps the properties facets can be requested for to their field in the index
+var accountFacetFields = map[string]string{
+	"account_enabled":          "account_enabled",
+	"is_resource_account":      "is_resource_account",
+	"creation_type":            "creation_type",
+	"memberOf":                 "memberOf.id",
+	"external_user_state":      "external_user_state",
+	"on_premises_sync_enabled": "on_premises_sync_enabled",
+	"on_premises_domain_name":  "on_premises_domain_name",
+}
+
+// groupFacetFields maps the properties facets can be requested for to their field in the index
+var groupFacetFields = map[string]string{
+	"visibility":               "visibility",
+	"hide_from_address_lists":  "hide_from_address_lists",
+	"members":                  "members.id",
+	"on_premises_sync_enabled": "on_premises_sync_enabled",
+	"on_premises_domain_name":  "on_premises_domain_name",
+}
+
+// facetsRequest builds the bleve facet requests for the requested properties
+func (s Service) facetsRequest(facets []string, fields map[string]string) (bleve.FacetsRequest, error) {
+	if len(facets) == 0 {
+		return nil, nil
+	}
+	fr := bleve.FacetsRequest{}
+	for _, name := range facets {
+		field, ok := fields[name]
+		if !ok {
+			return nil, merrors.BadRequest(s.id, "can not compute facet for '%s'", name)
+		}
+		fr[name] = bleve.NewFacetRequest(field, _facetSize)
+	}
+	return fr, nil
+}
+
+// facetsResponse converts the facet results of a bleve search in the order they were requested
+func facetsResponse(facets []string, results search.FacetResults) []*proto.Facet {
+	out := make([]*proto.Facet, 0, len(facets))
+	for _, name := range facets {
+		fr, ok := results[name]
+		if !ok {
+			continue
+		}
+		f := &proto.Facet{
+			Field:   name,
+			Total:   int32(fr.Total),
+			Missing: int32(fr.Missing),
+			Other:   int32(fr.Other),
+			Terms:   make([]*proto.FacetTerm, 0, len(fr.Terms)),
+		}
+		for _, t := range fr.Terms {
+			f.Terms = append(f.Terms, &proto.FacetTerm{
+				Term:  facetTerm(t.Term),
+				Count: int32(t.Count),
+			})
+		}
+		out = append(out, f)
+	}
+	return out
+}
+
+// facetTerm translates the terms bleve uses for boolean fields
+func facetTerm(term string) string {
+	switch term {
+	case "T":
+		return "true"
+	case "F":
+		return "false"
+	}
+	return term
+}
@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFacetTerm(t *testing.T) {
+	for term, expected := range map[string]string{
+		"T":          "true",
+		"F":          "false",
+		"physicists": "physicists",
+		"":           "",
+	} {
+		assert.Equal(t, expected, facetTerm(term), term)
+	}
+}
+
+func TestFacetsRequest(t *testing.T) {
+	svc := Service{id: "com.owncloud.api.accounts"}
+
+	fr, err := svc.facetsRequest(nil, accountFacetFields)
+	assert.NoError(t, err)
+	assert.Nil(t, fr, "no facets are computed unless requested")
+
+	fr, err = svc.facetsRequest([]string{"memberOf", "account_enabled"}, accountFacetFields)
+	assert.NoError(t, err)
+	if assert.Len(t, fr, 2) {
+		assert.Equal(t, "memberOf.id", fr["memberOf"].Field)
+		assert.Equal(t, "account_enabled", fr["account_enabled"].Field)
+		assert.Equal(t, _facetSize, fr["memberOf"].Size)
+	}
+
+	_, err = svc.facetsRequest([]string{"account_enabled", "mail"}, accountFacetFields)
+	assert.Error(t, err, "facets are only computed for known properties")
+	_, err = svc.facetsRequest([]string{"visibility"}, accountFacetFields)
+	assert.Error(t, err, "group properties are unknown for accounts")
+}
+
+func TestListFacets(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	for _, a := range []*proto.Account{
+		{Id: "einstein", AccountEnabled: true, MemberOf: []*proto.Group{{Id: "physicists"}}},
+		{Id: "marie", AccountEnabled: true, MemberOf: []*proto.Group{{Id: "physicists"}, {Id: "chemists"}}},
+		{Id: "richard", AccountEnabled: false},
+	} {
+		addTestAccount(t, svc, a, "")
+	}
+	for _, g := range []*proto.Group{
+		{Id: "physicists", Visibility: "Public"},
+		{Id: "chemists", Visibility: "Public"},
+		{Id: "sysusers", Visibility: "Private", HideFromAddressLists: true},
+	} {
+		addTestGroup(t, svc, g)
+	}
+
+	terms := func(f *proto.Facet) map[string]int32 {
+		m := map[string]int32{}
+		for _, t := range f.Terms {
+			m[t.Term] = t.Count
+		}
+		return m
+	}
+
+	accounts := &proto.ListAccountsResponse{}
+	assert.NoError(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: 1, Facets: []string{"memberOf", "account_enabled"}}, accounts))
+	assert.Len(t, accounts.Accounts, 1)
+	if assert.Len(t, accounts.Facets, 2) {
+		memberOf, enabled := accounts.Facets[0], accounts.Facets[1]
+		assert.Equal(t, "memberOf", memberOf.Field, "facets are returned in the requested order")
+		assert.Equal(t, map[string]int32{"physicists": 2, "chemists": 1}, terms(memberOf), "facets count all hits, not only the page")
+		assert.Equal(t, int32(1), memberOf.Missing)
+		assert.Equal(t, "account_enabled", enabled.Field)
+		assert.Equal(t, map[string]int32{"true": 2, "false": 1}, terms(enabled))
+		assert.Equal(t, int32(3), enabled.Total)
+	}
+
+	noFacets := &proto.ListAccountsResponse{}
+	assert.NoError(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: 10}, noFacets))
+	assert.Empty(t, noFacets.Facets)
+	assert.Error(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{Facets: []string{"mail"}}, &proto.ListAccountsResponse{}))
+
+	groups := &proto.ListGroupsResponse{}
+	assert.NoError(t, svc.ListGroups(context.Background(), &proto.ListGroupsRequest{PageSize: 10, Facets: []string{"hide_from_address_lists", "visibility"}}, groups))
+	if assert.Len(t, groups.Facets, 2) {
+		assert.Equal(t, "hide_from_address_lists", groups.Facets[0].Field)
+		hidden, visibility := terms(groups.Facets[0]), terms(groups.Facets[1])
+		assert.Equal(t, map[string]int32{"true": 1, "false": 2}, hidden)
+		assert.Equal(t, int32(2), visibility["Public"])
+		assert.Equal(t, int32(1), visibility["Private"])
+	}
+}
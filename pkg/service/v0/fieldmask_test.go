@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestGoFieldName(t *testing.T) {
+	for name, expected := range map[string]string{
+		"id":                           "Id",
+		"member_of":                    "MemberOf",
+		"on_premises_sam_account_name": "OnPremisesSamAccountName",
+		"memberOf":                     "MemberOf",
+		"DisplayName":                  "DisplayName",
+	} {
+		assert.Equal(t, expected, goFieldName(name), name)
+	}
+}
+
+func TestReadMasks(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	a := &proto.Account{
+		Id:              "einstein",
+		DisplayName:     "Albert Einstein",
+		PreferredName:   "einstein",
+		PasswordProfile: &proto.PasswordProfile{Password: "$2a$11$4WNffzgU/WrIRiDnwu8OnOwgOIIUqR/2Ptvp7WJAQCTSgSrylyuvC"},
+		MemberOf:        []*proto.Group{{Id: "physicists"}},
+	}
+	addTestAccount(t, svc, a, "")
+	addTestGroup(t, svc, &proto.Group{Id: "physicists", DisplayName: "Physicists", Members: []*proto.Account{{Id: "einstein"}}})
+
+	ctx := context.Background()
+	mask := func(paths ...string) *field_mask.FieldMask {
+		return &field_mask.FieldMask{Paths: paths}
+	}
+
+	t.Run("GetAccount", func(t *testing.T) {
+		all := &proto.Account{}
+		assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "einstein"}, all))
+		assert.Equal(t, "einstein", all.PreferredName)
+		if assert.Len(t, all.MemberOf, 1) {
+			assert.Equal(t, "Physicists", all.MemberOf[0].DisplayName, "groups are expanded without a mask")
+		}
+
+		snake := &proto.Account{}
+		assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "einstein", FieldMask: mask("display_name", "member_of.display_name", "password_profile.password")}, snake))
+		assert.Empty(t, snake.Id)
+		assert.Empty(t, snake.PreferredName)
+		assert.Equal(t, "Albert Einstein", snake.DisplayName)
+		if assert.Len(t, snake.MemberOf, 1) {
+			assert.Empty(t, snake.MemberOf[0].Id)
+			assert.Equal(t, "Physicists", snake.MemberOf[0].DisplayName)
+		}
+		if snake.PasswordProfile != nil {
+			assert.Empty(t, snake.PasswordProfile.Password, "masks never select secrets")
+		}
+
+		ids := &proto.Account{}
+		assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "einstein", FieldMask: mask("Id", "MemberOf.Id")}, ids))
+		assert.Equal(t, "einstein", ids.Id)
+		if assert.Len(t, ids.MemberOf, 1) {
+			assert.Equal(t, "physicists", ids.MemberOf[0].Id)
+			assert.Empty(t, ids.MemberOf[0].DisplayName, "groups are not expanded for their ids")
+		}
+	})
+
+	t.Run("GetGroup", func(t *testing.T) {
+		got := &proto.Group{}
+		assert.NoError(t, svc.GetGroup(ctx, &proto.GetGroupRequest{Id: "physicists", FieldMask: mask("display_name", "members.preferred_name")}, got))
+		assert.Empty(t, got.Id)
+		assert.Equal(t, "Physicists", got.DisplayName)
+		if assert.Len(t, got.Members, 1) {
+			assert.Empty(t, got.Members[0].Id)
+			assert.Equal(t, "einstein", got.Members[0].PreferredName)
+		}
+
+		ids := &proto.Group{}
+		assert.NoError(t, svc.GetGroup(ctx, &proto.GetGroupRequest{Id: "physicists", FieldMask: mask("members.id")}, ids))
+		if assert.Len(t, ids.Members, 1) {
+			assert.Equal(t, "einstein", ids.Members[0].Id)
+			assert.Empty(t, ids.Members[0].PreferredName, "members are not expanded for their ids")
+		}
+	})
+
+	t.Run("empty masks expand related records", func(t *testing.T) {
+		accounts := &proto.ListAccountsResponse{}
+		assert.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{PageSize: 10}, accounts))
+		members := &proto.ListMembersResponse{}
+		assert.NoError(t, svc.ListMembers(ctx, &proto.ListMembersRequest{Id: "physicists", PageSize: 10}, members))
+		for _, list := range [][]*proto.Account{accounts.Accounts, members.Members} {
+			if assert.Len(t, list, 1) && assert.Len(t, list[0].MemberOf, 1) {
+				assert.Equal(t, "Physicists", list[0].MemberOf[0].DisplayName)
+			}
+		}
+	})
+}
@@ -0,0 +1,112 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/CiscoM31/godata"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-accounts/pkg/provider"
+)
+
+// filter languages supported by the query of list requests
+const (
+	filterLanguageOData = "odata"
+	filterLanguageSCIM  = "scim"
+	filterLanguageLDAP  = "ldap"
+)
+
+// parseFilter converts the query of a list request written in the given filter language into a bleve query.
+// scimAttributes maps the SCIM attributes of the listed resource to fields in the index. Invalid queries are reported
+// as a provider.QueryError.
+func (s Service) parseFilter(language string, q string, scimAttributes map[string]string) (query.Query, *provider.QueryError) {
+	var bq query.Query
+	var err error
+	var operators []string
+	switch strings.ToLower(language) {
+	case "", filterLanguageOData:
+		operators = provider.ODataOperators
+		// parse the query like an odata filter
+		var fq *godata.GoDataFilterQuery
+		if fq, err = godata.ParseFilterString(q); err == nil {
+			bq, err = provider.BuildBleveQuery(fq)
+		}
+	case filterLanguageSCIM:
+		operators = provider.SCIMOperators
+		bq, err = provider.BuildBleveQueryFromSCIMFilter(q, scimAttributes)
+	case filterLanguageLDAP:
+		operators = provider.LDAPOperators
+		bq, err = provider.BuildBleveQueryFromLDAPFilter(q, provider.LDAPAttributes(s.Config.LDAP.Schema))
+	default:
+		return nil, &provider.QueryError{Message: "unsupported filter language", Token: language, Position: -1}
+	}
+	if err != nil {
+		return nil, provider.AsQueryError(err, operators)
+	}
+	return bq, nil
+}
+
+// buildFilterQuery parses the query of a list request, invalid queries are returned as a BadRequest
+func (s Service) buildFilterQuery(language string, q string, scimAttributes map[string]string) (query.Query, error) {
+	bq, qe := s.parseFilter(language, q, scimAttributes)
+	if qe != nil {
+		s.log.Debug().Str("language", language).Str("query", q).Interface("error", qe).Msg("invalid query")
+		return nil, s.queryErrorResponse(qe)
+	}
+	return bq, nil
+}
+
+// queryErrorResponse returns a BadRequest with the QueryError as json in the detail, so clients can point users to
+// the offending token
+func (s Service) queryErrorResponse(qe *provider.QueryError) error {
+	detail, err := json.Marshal(qe)
+	if err != nil {
+		return merrors.BadRequest(s.id, "invalid query: %v", qe.Error())
+	}
+	return &merrors.Error{
+		Id:     s.id,
+		Code:   http.StatusBadRequest,
+		Detail: string(detail),
+		Status: http.StatusText(http.StatusBadRequest),
+	}
+}
+
+// recordType returns the type of records in the index and the SCIM attributes for the type of a request, which
+// defaults to accounts
+func (s Service) recordType(t string) (string, map[string]string, error) {
+	switch t {
+	case "", "account":
+		return "account", provider.SCIMUserAttributes, nil
+	case "group":
+		return "group", provider.SCIMGroupAttributes, nil
+	}
+	return "", nil, merrors.BadRequest(s.id, "unsupported type '%s', expected account or group", t)
+}
+
+// ValidateQuery implements the AccountsServiceHandler interface
+func (s Service) ValidateQuery(ctx context.Context, in *proto.ValidateQueryRequest, out *proto.ValidateQueryResponse) (err error) {
+	if strings.TrimSpace(in.Query) == "" {
+		return merrors.BadRequest(s.id, "query must not be empty")
+	}
+
+	_, scimAttributes, err := s.recordType(in.Type)
+	if err != nil {
+		return err
+	}
+
+	if _, qe := s.parseFilter(in.FilterLanguage, in.Query, scimAttributes); qe != nil {
+		out.Error = &proto.QueryError{
+			Message:            qe.Message,
+			Token:              qe.Token,
+			Position:           int32(qe.Position),
+			SupportedOperators: qe.SupportedOperators,
+		}
+		return nil
+	}
+	out.Valid = true
+	return nil
+}
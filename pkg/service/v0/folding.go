@@ -0,0 +1,26 @@
+package service
+
+import (
+	"github.com/blevesearch/bleve/analysis"
+	"github.com/blevesearch/bleve/registry"
+	"github.com/owncloud/ocis-accounts/pkg/provider"
+)
+
+// foldingFilterName is the name of the token filter removing diacritics
+const foldingFilterName = "fold"
+
+// foldingFilter is a bleve token filter that removes diacritics from all tokens, see provider.Fold
+type foldingFilter struct{}
+
+func (f *foldingFilter) Filter(input analysis.TokenStream) analysis.TokenStream {
+	for _, token := range input {
+		token.Term = []byte(provider.Fold(string(token.Term)))
+	}
+	return input
+}
+
+func init() {
+	registry.RegisterTokenFilter(foldingFilterName, func(config map[string]interface{}, cache *registry.Cache) (analysis.TokenFilter, error) {
+		return &foldingFilter{}, nil
+	})
+}
@@ -8,13 +8,14 @@ import (
 	"path/filepath"
 	"sync"
 
-	"github.com/CiscoM31/godata"
 	"github.com/blevesearch/bleve"
 	"github.com/gofrs/uuid"
 	"github.com/golang/protobuf/ptypes/empty"
+	fieldmask_utils "github.com/mennanov/fieldmask-utils"
 	merrors "github.com/micro/go-micro/v2/errors"
 	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
 	"github.com/owncloud/ocis-accounts/pkg/provider"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // accLock mutually exclude readers from writers on group files
@@ -51,6 +52,13 @@ func (s Service) indexGroup(id string) error {
 		return err
 	}
 	s.log.Debug().Interface("group", g).Msg("found group")
+	if s.Config.Search.StoreRecords {
+		var err error
+		if g.Source, err = groupSource(&g.Group); err != nil {
+			s.log.Error().Err(err).Str("group", id).Msg("could not marshal group")
+			return err
+		}
+	}
 	if err := s.index.Index(g.Id, g); err != nil {
 		s.log.Error().Err(err).Interface("group", g).Msg("could not index group")
 		return err
@@ -79,6 +87,8 @@ func (s Service) writeGroup(g *proto.Group) (err error) {
 
 	// leave only the member id
 	s.deflateMembers(g)
+	g.MemberGroups = s.deflateGroups(g.Id, g.MemberGroups)
+	g.MemberOf = s.deflateGroups(g.Id, g.MemberOf)
 
 	var bytes []byte
 	if bytes, err = json.Marshal(g); err != nil {
@@ -99,18 +109,28 @@ func (s Service) expandMembers(g *proto.Group) {
 	if g == nil {
 		return
 	}
-	expanded := []*proto.Account{}
-	for i := range g.Members {
-		// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
-		a := &proto.Account{}
-		if err := s.loadAccount(g.Members[i].Id, a); err == nil {
-			expanded = append(expanded, a)
-		} else {
-			// log errors but continue execution for now
-			s.log.Error().Err(err).Str("id", g.Members[i].Id).Msg("could not load account")
+	s.expandGroupsMembers([]*proto.Group{g})
+}
+
+// expandGroupsMembers replaces the members of the groups with the full accounts, loading every account only once
+func (s Service) expandGroupsMembers(groups []*proto.Group) {
+	ids := []string{}
+	for _, g := range groups {
+		for i := range g.Members {
+			ids = append(ids, g.Members[i].Id)
+		}
+	}
+	accounts := s.loadAccounts(ids)
+	for _, g := range groups {
+		expanded := []*proto.Account{}
+		for i := range g.Members {
+			// TODO resolve by name, when a create or update is issued they may not have an id? fall back to searching the group id in the index?
+			if a, ok := accounts[g.Members[i].Id]; ok {
+				expanded = append(expanded, a)
+			}
 		}
+		g.Members = expanded
 	}
-	g.Members = expanded
 }
 
 // deflateMembers replaces the users of a group with an instance that only contains the id
@@ -130,6 +150,30 @@ func (s Service) deflateMembers(g *proto.Group) {
 	g.Members = deflated
 }
 
+// sortableGroupFields maps the properties groups can be ordered by to their sortable field in the index
+var sortableGroupFields = map[string]string{
+	"id":                           "_id",
+	"display_name":                 "display_name_sort",
+	"on_premises_sam_account_name": "on_premises_sam_account_name_sort",
+	"gid_number":                   "gid_number",
+	"created_date_time":            "created_date_time.seconds",
+}
+
+// projectGroup returns a copy of the group that only contains the fields selected by the mask
+func (s Service) projectGroup(mask fieldmask_utils.Mask, g *proto.Group) (*proto.Group, error) {
+	if mask.IsEmpty() {
+		return g, nil
+	}
+	projected := &proto.Group{}
+	if err := fieldmask_utils.StructToStruct(mask, g, projected); err != nil {
+		return nil, merrors.BadRequest(s.id, "could not apply field mask: %v", err.Error())
+	}
+	return projected, nil
+}
+
+// searchableGroupFields are the fields used for free text searches on groups
+var searchableGroupFields = []string{"display_name", "on_premises_sam_account_name", "description"}
+
 // ListGroups implements the GroupsServiceHandler interface
 func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out *proto.ListGroupsResponse) (err error) {
 
@@ -140,48 +184,68 @@ func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out
 	query := bleve.NewConjunctionQuery(tq)
 
 	if in.Query != "" {
-		// parse the query like an odata filter
-		var q *godata.GoDataFilterQuery
-		if q, err = godata.ParseFilterString(in.Query); err != nil {
-			s.log.Error().Err(err).Msg("could not parse query")
-			return merrors.InternalServerError(s.id, "could not parse query: %v", err.Error())
-		}
-
-		// convert to bleve query
-		bq, err := provider.BuildBleveQuery(q)
+		bq, err := s.buildFilterQuery(in.FilterLanguage, in.Query, provider.SCIMGroupAttributes)
 		if err != nil {
-			s.log.Error().Err(err).Msg("could not build bleve query")
-			return merrors.InternalServerError(s.id, "could not build bleve query: %v", err.Error())
+			return err
 		}
 		query.AddQuery(bq)
 	}
 
+	if in.Search != "" {
+		query.AddQuery(provider.BuildBleveSearchQuery(in.Search, searchableGroupFields))
+	}
+
 	s.log.Debug().Interface("query", query).Msg("using query")
 
-	searchRequest := bleve.NewSearchRequest(query)
-	var searchResult *bleve.SearchResult
-	searchResult, err = s.index.Search(searchRequest)
+	order, err := s.sortOrder(in.OrderBy, sortableGroupFields, in.Search != "")
+	if err != nil {
+		return err
+	}
+
+	mask, err := s.readMask(in.FieldMask)
 	if err != nil {
-		s.log.Error().Err(err).Msg("could not execute bleve search")
-		return merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+		return err
 	}
 
-	s.log.Debug().Interface("result", searchResult).Msg("result")
+	facets, err := s.facetsRequest(in.Facets, groupFacetFields)
+	if err != nil {
+		return err
+	}
+
+	searchResult, nextPageToken, err := s.searchPage(c, query, order, facets, in.PageSize, in.PageToken, "group", in.FilterLanguage, in.Query, in.Search)
+	if err != nil {
+		return err
+	}
 
 	out.Groups = make([]*proto.Group, 0)
+	out.NextPageToken = nextPageToken
+	if in.IncludeTotalSize {
+		out.TotalSize = int32(searchResult.Total)
+	}
+	if len(in.Facets) > 0 {
+		out.Facets = facetsResponse(in.Facets, searchResult.Facets)
+	}
 
+	groups := make([]*proto.Group, 0, len(searchResult.Hits))
 	for _, hit := range searchResult.Hits {
 
 		g := &proto.Group{}
-		if err = s.loadGroup(hit.ID, g); err != nil {
+		if err = s.groupFromHit(hit, g); err != nil {
 			s.log.Error().Err(err).Str("group", hit.ID).Msg("could not load group, skipping")
 			continue
 		}
 		s.log.Debug().Interface("group", g).Msg("found group")
+		groups = append(groups, g)
+	}
+
+	if expansionRequested(mask, "Members") {
+		s.expandGroupsMembers(groups)
+	}
 
-		// TODO add accounts if requested
-		// if in.FieldMask ...
-		s.expandMembers(g)
+	for _, g := range groups {
+		if g, err = s.projectGroup(mask, g); err != nil {
+			return err
+		}
 
 		out.Groups = append(out.Groups, g)
 	}
@@ -191,6 +255,11 @@ func (s Service) ListGroups(c context.Context, in *proto.ListGroupsRequest, out
 
 // GetGroup implements the GroupsServiceHandler interface
 func (s Service) GetGroup(c context.Context, in *proto.GetGroupRequest, out *proto.Group) (err error) {
+	mask, err := s.readMask(in.FieldMask)
+	if err != nil {
+		return err
+	}
+
 	var id string
 	if id, err = cleanupID(in.Id); err != nil {
 		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
@@ -202,10 +271,15 @@ func (s Service) GetGroup(c context.Context, in *proto.GetGroupRequest, out *pro
 	}
 	s.log.Debug().Interface("group", out).Msg("found group")
 
-	// TODO only add accounts if requested
-	// if in.FieldMask ...
-	s.expandMembers(out)
+	if expansionRequested(mask, "Members") {
+		s.expandMembers(out)
+	}
 
+	projected, err := s.projectGroup(mask, out)
+	if err != nil {
+		return err
+	}
+	*out = *projected
 	return
 }
 
@@ -223,9 +297,15 @@ func (s Service) CreateGroup(c context.Context, in *proto.CreateGroupRequest, ou
 		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
 	}
 
+	in.Group.CreatedDateTime = timestamppb.Now()
+
 	// extract member id
 	s.deflateMembers(in.Group)
 
+	// nested groups need to be added with AddMemberGroup, which keeps both sides of the relation in sync
+	in.Group.MemberGroups = nil
+	in.Group.MemberOf = nil
+
 	if err = s.writeGroup(in.Group); err != nil {
 		s.log.Error().Err(err).Interface("group", in.Group).Msg("could not persist new group")
 		return
@@ -267,6 +347,26 @@ func (s Service) DeleteGroup(c context.Context, in *proto.DeleteGroupRequest, ou
 			s.log.Error().Err(err).Str("groupid", id).Str("accountid", g.Members[i].Id).Msg("could not remove account memberof, skipping")
 		}
 	}
+
+	// delete nesting relationship in member and parent groups
+	for i := range g.MemberGroups {
+		err = s.RemoveMemberGroup(c, &proto.RemoveMemberGroupRequest{
+			GroupId:       id,
+			MemberGroupId: g.MemberGroups[i].Id,
+		}, &proto.Group{})
+		if err != nil {
+			s.log.Error().Err(err).Str("groupid", id).Str("membergroupid", g.MemberGroups[i].Id).Msg("could not remove group memberof, skipping")
+		}
+	}
+	for i := range g.MemberOf {
+		err = s.RemoveMemberGroup(c, &proto.RemoveMemberGroupRequest{
+			GroupId:       g.MemberOf[i].Id,
+			MemberGroupId: id,
+		}, &proto.Group{})
+		if err != nil {
+			s.log.Error().Err(err).Str("groupid", g.MemberOf[i].Id).Str("membergroupid", id).Msg("could not remove member group, skipping")
+		}
+	}
 	if err = os.Remove(path); err != nil {
 		s.log.Error().Err(err).Str("id", id).Str("path", path).Msg("could not remove group")
 		return merrors.InternalServerError(s.id, "could not remove group: %v", err.Error())
@@ -339,7 +439,12 @@ func (s Service) AddMember(c context.Context, in *proto.AddMemberRequest, out *p
 		s.log.Error().Err(err).Interface("group", g).Msg("could not persist group")
 		return
 	}
-	// FIXME update index!
+	if err = s.indexAccount(a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	if err = s.indexGroup(g.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index group: %v", err.Error())
+	}
 	// TODO rollback changes when only one of them failed?
 	// TODO store relation in another file?
 	// TODO return error if they are already related?
@@ -399,7 +504,12 @@ func (s Service) RemoveMember(c context.Context, in *proto.RemoveMemberRequest,
 		s.log.Error().Err(err).Interface("group", g).Msg("could not persist group")
 		return
 	}
-	// FIXME update index!
+	if err = s.indexAccount(a.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index account: %v", err.Error())
+	}
+	if err = s.indexGroup(g.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index group: %v", err.Error())
+	}
 	// TODO rollback changes when only one of them failed?
 	// TODO store relation in another file?
 	// TODO return error if they are not related?
@@ -421,11 +531,55 @@ func (s Service) ListMembers(c context.Context, in *proto.ListMembersRequest, ou
 		return
 	}
 
-	// TODO only expand accounts if requested
-	// if in.FieldMask ...
-	s.expandMembers(g)
+	// search the members in the index so we can page through large groups
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+	mq := bleve.NewTermQuery(groupID)
+	mq.SetField("memberOf.id")
+	query := bleve.NewConjunctionQuery(tq, mq)
 
-	out.Members = g.Members
+	order, err := s.sortOrder(in.OrderBy, sortableAccountFields, false)
+	if err != nil {
+		return err
+	}
 
-	return
+	mask, err := s.readMask(in.FieldMask)
+	if err != nil {
+		return err
+	}
+
+	searchResult, nextPageToken, err := s.searchPage(c, query, order, nil, in.PageSize, in.PageToken, "member", groupID)
+	if err != nil {
+		return err
+	}
+
+	out.Members = make([]*proto.Account, 0)
+	out.NextPageToken = nextPageToken
+
+	members := make([]*proto.Account, 0, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		a := &proto.Account{}
+		if err = s.accountFromHit(hit, a); err != nil {
+			s.log.Error().Err(err).Str("account", hit.ID).Msg("could not load account, skipping")
+			continue
+		}
+		members = append(members, a)
+	}
+
+	if expansionRequested(mask, "MemberOf") {
+		s.expandAccountsMemberOf(members)
+	}
+
+	for _, a := range members {
+		// remove passwords
+		removeSecrets(a)
+
+		if a, err = s.projectAccount(mask, a); err != nil {
+			return err
+		}
+
+		out.Members = append(out.Members, a)
+	}
+
+	return nil
 }
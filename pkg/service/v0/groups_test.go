@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupMembersWithoutSecrets(t *testing.T) {
+	for _, storeRecords := range []bool{false, true} {
+		svc, teardown := newTestService(t)
+		svc.Config.Search.StoreRecords = storeRecords
+
+		a := &proto.Account{
+			Id:             "einstein",
+			AccountEnabled: true,
+			PasswordProfile: &proto.PasswordProfile{
+				Password:        "$2a$11$4WNffzgU/WrIRiDnwu8OnOwgOIIUqR/2Ptvp7WJAQCTSgSrylyuvC",
+				PasswordHistory: []string{"$2a$11$old"},
+			},
+			MultiFactorProfile: &proto.MultiFactorProfile{
+				TotpEnabled:         true,
+				EncryptedTotpSecret: "encrypted",
+				LastTotpStep:        42,
+				RecoveryCodeHashes:  []string{"recovery"},
+			},
+			AppPasswords: []*proto.AppPassword{{Id: "phone", Hash: "hash"}},
+		}
+		addTestAccount(t, svc, a, "")
+		addTestGroup(t, svc, &proto.Group{Id: "physicists", DisplayName: "Physicists", Members: []*proto.Account{{Id: "einstein"}}})
+
+		got := &proto.Group{}
+		assert.NoError(t, svc.GetGroup(context.Background(), &proto.GetGroupRequest{Id: "physicists"}, got))
+		list := &proto.ListGroupsResponse{}
+		assert.NoError(t, svc.ListGroups(context.Background(), &proto.ListGroupsRequest{PageSize: 100}, list))
+		var listed *proto.Group
+		for _, group := range list.Groups {
+			if group.Id == "physicists" {
+				listed = group
+			}
+		}
+
+		if assert.NotNil(t, listed, "stored records: %v", storeRecords) {
+			for _, group := range []*proto.Group{got, listed} {
+				if !assert.Len(t, group.Members, 1, "stored records: %v", storeRecords) {
+					continue
+				}
+				m := group.Members[0]
+				assert.Empty(t, m.PasswordProfile.Password)
+				assert.Empty(t, m.PasswordProfile.PasswordHistory)
+				assert.True(t, m.MultiFactorProfile.TotpEnabled)
+				assert.Empty(t, m.MultiFactorProfile.EncryptedTotpSecret)
+				assert.Zero(t, m.MultiFactorProfile.LastTotpStep)
+				assert.Empty(t, m.MultiFactorProfile.RecoveryCodeHashes)
+				if assert.Len(t, m.AppPasswords, 1) {
+					assert.Empty(t, m.AppPasswords[0].Hash)
+				}
+			}
+		}
+		teardown()
+	}
+}
@@ -0,0 +1,93 @@
+package service
+
+import (
+	"fmt"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+func (s Service) passwordHistorySize() int {
+	if s.Config.Auth.PasswordHistory > 0 {
+		return s.Config.Auth.PasswordHistory
+	}
+	return 0
+}
+
+// checkPasswordReuse rejects a new password that matches the current password hash or one of the previous password
+// hashes kept in the history. Every comparison costs as much as a sign-in, so callers compare without holding the
+// account lock first and pass the compared hashes as checked when they check again under the lock. Only hashes
+// written in the meantime are compared then. It returns the compared hashes.
+func (s Service) checkPasswordReuse(current string, history []string, pwd string, checked []string) ([]string, error) {
+	n := s.passwordHistorySize()
+	if n == 0 {
+		return nil, nil
+	}
+	if len(history) > n {
+		history = history[len(history)-n:]
+	}
+	compared := checked
+	skip := make(map[string]bool, len(checked))
+	for _, hash := range checked {
+		skip[hash] = true
+	}
+	for _, hash := range append([]string{current}, history...) {
+		if hash == "" || skip[hash] {
+			continue
+		}
+		if ok, _ := s.passwordIsValid(hash, pwd); ok {
+			v := password.Violation{Rule: password.RuleHistory, Message: fmt.Sprintf("must not be one of the last %d passwords", n)}
+			return nil, merrors.BadRequest(s.id, "password does not meet the password policy: %s", v)
+		}
+		compared = append(compared, hash)
+	}
+	return compared, nil
+}
+
+// precheckPasswordReuse compares a new password with the password hashes of the account before the caller takes the
+// account lock. The lock is only held to read the hashes.
+func (s Service) precheckPasswordReuse(id string, pwd string) ([]string, error) {
+	if s.passwordHistorySize() == 0 {
+		return nil, nil
+	}
+	a := &proto.Account{}
+	accLock.Lock()
+	err := s.loadAccount(id, a)
+	accLock.Unlock()
+	if err != nil {
+		// the account is loaded again under the lock, which reports the error
+		return nil, nil
+	}
+	return s.checkPasswordReuse(a.GetPasswordProfile().GetPassword(), a.GetPasswordProfile().GetPasswordHistory(), pwd, nil)
+}
+
+// reloadForPasswordChange loads the account again after the new password was compared with the history without the
+// account lock. The caller proved to know the password, so it fails if the password was changed in the meantime.
+func (s Service) reloadForPasswordChange(a *proto.Account) error {
+	hash := a.GetPasswordProfile().GetPassword()
+	if err := s.loadAccount(a.Id, a); err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not load account")
+		return err
+	}
+	if a.GetPasswordProfile().GetPassword() != hash {
+		return merrors.Conflict(s.id, "the password was changed in the meantime, try again")
+	}
+	return nil
+}
+
+// appendPasswordHistory adds the replaced password hash to the history and drops the oldest hashes beyond the
+// configured history size
+func (s Service) appendPasswordHistory(history []string, replaced string) []string {
+	n := s.passwordHistorySize()
+	if replaced != "" {
+		history = append(history, replaced)
+	}
+	if len(history) > n {
+		history = history[len(history)-n:]
+	}
+	if len(history) == 0 {
+		return nil
+	}
+	return history
+}
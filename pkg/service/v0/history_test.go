@@ -0,0 +1,101 @@
+package service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestPasswordHistory(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.PasswordHistory = 2
+	var err error
+	svc.passwords, err = password.NewRegistry(password.BCrypt, bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	addTestAccount(t, svc, einstein(), "first")
+
+	current := "first"
+	changePassword := func(new string) error {
+		out := &proto.AuthenticateAccountResponse{}
+		if err := svc.ChangePassword(context.Background(), &proto.ChangePasswordRequest{Login: "einstein", CurrentPassword: current, NewPassword: new}, out); err != nil {
+			return err
+		}
+		assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, out.Failure)
+		if assert.NotNil(t, out.Account) {
+			assert.Empty(t, out.Account.PasswordProfile.PasswordHistory, "the history is never returned")
+		}
+		current = new
+		return nil
+	}
+
+	assert.Error(t, changePassword("first"), "the current password cannot be used again")
+	assert.NoError(t, changePassword("second"))
+	assert.NoError(t, changePassword("third"))
+	assert.Error(t, changePassword("first"))
+	assert.Error(t, changePassword("second"))
+	assert.NoError(t, changePassword("fourth"))
+	assert.NoError(t, changePassword("first"), "only the configured number of passwords is kept")
+
+	stored := &proto.Account{}
+	assert.NoError(t, svc.loadAccount("einstein", stored))
+	assert.Len(t, stored.PasswordProfile.PasswordHistory, 2)
+
+	// admins cannot set a previous password either
+	update := func(pwd string) error {
+		return svc.UpdateAccount(context.Background(), &proto.UpdateAccountRequest{
+			Account:    &proto.Account{Id: "einstein", PasswordProfile: &proto.PasswordProfile{Password: pwd}},
+			UpdateMask: &field_mask.FieldMask{Paths: []string{"PasswordProfile.Password"}},
+		}, &proto.Account{})
+	}
+	assert.Error(t, update("fourth"))
+	assert.NoError(t, update("fifth"))
+
+	got := &proto.Account{}
+	assert.NoError(t, svc.GetAccount(context.Background(), &proto.GetAccountRequest{Id: "einstein"}, got))
+	assert.Empty(t, got.PasswordProfile.PasswordHistory)
+
+	// a history size of 0 disables the check
+	svc.Config.Auth.PasswordHistory = 0
+	assert.NoError(t, update("fifth"))
+}
+
+func TestCheckPasswordReuseOutsideTheLock(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.PasswordHistory = 2
+	var err error
+	svc.passwords, err = password.NewRegistry(password.BCrypt, bcrypt.MinCost)
+	assert.NoError(t, err)
+
+	first, err := svc.passwords.Hash("first")
+	assert.NoError(t, err)
+	second, err := svc.passwords.Hash("second")
+	assert.NoError(t, err)
+
+	checked, err := svc.checkPasswordReuse(second, []string{first}, "third", nil)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []string{first, second}, checked)
+
+	// only the hash written in the meantime is compared again
+	third, err := svc.passwords.Hash("third")
+	assert.NoError(t, err)
+	_, err = svc.checkPasswordReuse(third, []string{first, second}, "third", checked)
+	assert.Error(t, err)
+	_, err = svc.checkPasswordReuse(second, []string{first}, "second", []string{second})
+	assert.NoError(t, err, "checked hashes are skipped")
+
+	// the password must not change between authenticating and setting the new password
+	addTestAccount(t, svc, einstein(), "first")
+	authenticated := &proto.Account{}
+	assert.NoError(t, svc.loadAccount("einstein", authenticated))
+	assert.NoError(t, svc.reloadForPasswordChange(authenticated))
+	addTestAccount(t, svc, einstein(), "second")
+	assert.Error(t, svc.reloadForPasswordChange(authenticated))
+}
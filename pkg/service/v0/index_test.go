@@ -0,0 +1,227 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/CiscoM31/godata"
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-accounts/pkg/provider"
+	"github.com/stretchr/testify/assert"
+)
+
+var multilingualAccounts = []proto.Account{
+	{Id: "1", DisplayName: "Hans Müller"},
+	{Id: "2", DisplayName: "José García"},
+	{Id: "3", DisplayName: "Zoë Brontë"},
+	{Id: "4", DisplayName: "Åsa Öberg"},
+	{Id: "5", DisplayName: "Søren Kierkegaard"},
+	{Id: "6", DisplayName: "Łukasz Wałęsa"},
+	{Id: "7", DisplayName: "François Lefèvre"},
+	{Id: "8", DisplayName: "Günther Straße"},
+	{Id: "9", DisplayName: "MARIE CURIE"},
+}
+
+func TestNameSearchIgnoresCaseAndDiacritics(t *testing.T) {
+	indexMapping, err := buildIndexMapping()
+	assert.NoError(t, err)
+	index, err := bleve.NewMemOnly(indexMapping)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	for i := range multilingualAccounts {
+		a := &proto.BleveAccount{
+			Account:   multilingualAccounts[i],
+			BleveType: "account",
+		}
+		assert.NoError(t, index.Index(a.Id, a))
+	}
+
+	var scenarios = []struct {
+		search   string
+		expected string
+	}{
+		{"muller", "1"},
+		{"Müller", "1"},
+		{"MULLER", "1"},
+		{"jose", "2"},
+		{"garcia", "2"},
+		{"zoe", "3"},
+		{"asa", "4"},
+		{"oberg", "4"},
+		{"soren", "5"},
+		{"lukasz", "6"},
+		{"walesa", "6"},
+		{"francois", "7"},
+		{"lefevre", "7"},
+		{"gunther", "8"},
+		{"strasse", "8"},
+		{"marie", "9"},
+		{"Curie", "9"},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.search, func(t *testing.T) {
+			q := bleve.NewMatchQuery(scenario.search)
+			q.SetField("display_name")
+			result, err := index.Search(bleve.NewSearchRequest(q))
+			assert.NoError(t, err)
+			if assert.Len(t, result.Hits, 1) {
+				assert.Equal(t, scenario.expected, result.Hits[0].ID)
+			}
+		})
+	}
+}
+
+func TestPrincipalsQuery(t *testing.T) {
+	indexMapping, err := buildIndexMapping()
+	assert.NoError(t, err)
+	index, err := bleve.NewMemOnly(indexMapping)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	accounts := []proto.Account{
+		{Id: "marie", DisplayName: "Marie Curie", PreferredName: "marie", Mail: "marie@example.org", AccountEnabled: true},
+		{Id: "maria", DisplayName: "Maria Disabled", PreferredName: "maria", AccountEnabled: false},
+		{Id: "mario", DisplayName: "Mario Technical", PreferredName: "mario", AccountEnabled: true, MemberOf: []*proto.Group{{Id: "sysusers"}}},
+	}
+	for i := range accounts {
+		assert.NoError(t, index.Index(accounts[i].Id, &proto.BleveAccount{Account: accounts[i], BleveType: "account"}))
+	}
+	groups := []proto.Group{
+		{Id: "sysusers", DisplayName: "Machines", HideFromAddressLists: true},
+		{Id: "mathematicians", DisplayName: "Mathematicians"},
+	}
+	for i := range groups {
+		assert.NoError(t, index.Index(groups[i].Id, &proto.BleveGroup{Group: groups[i], BleveType: "group"}))
+	}
+
+	var scenarios = []struct {
+		query    string
+		expected []string
+	}{
+		{"ma", []string{"marie", "mathematicians"}},
+		{"Mar", []string{"marie"}},
+		{"marie cu", []string{"marie"}},
+		{"curie", []string{"marie"}},
+		{"mach", []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.query, func(t *testing.T) {
+			result, err := index.Search(bleve.NewSearchRequest(principalsQuery(scenario.query, []string{"sysusers"})))
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+}
+
+func TestAnalyzeValues(t *testing.T) {
+	indexMapping, err := buildIndexMapping()
+	assert.NoError(t, err)
+	index, err := bleve.NewMemOnly(indexMapping)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	mq := bleve.NewMatchQuery("Zoë Brontë")
+	mq.SetField("display_name")
+	tq := bleve.NewTermQuery("account")
+	tq.SetField("bleve_type")
+
+	out := &proto.ExplainQueryResponse{}
+	Service{index: index}.analyzeValues(bleve.NewConjunctionQuery(tq, mq), out)
+
+	if assert.Len(t, out.AnalyzedValues, 2) {
+		assert.Equal(t, "bleve_type", out.AnalyzedValues[0].Field)
+		assert.Equal(t, []string{"account"}, out.AnalyzedValues[0].Tokens)
+		assert.Equal(t, "display_name", out.AnalyzedValues[1].Field)
+		assert.Equal(t, "name", out.AnalyzedValues[1].Analyzer)
+		assert.Equal(t, []string{"zoe", "bronte"}, out.AnalyzedValues[1].Tokens)
+	}
+}
+
+func TestIndexMappingTypes(t *testing.T) {
+	indexMapping, err := buildIndexMapping()
+	assert.NoError(t, err)
+	index, err := bleve.NewMemOnly(indexMapping)
+	assert.NoError(t, err)
+	defer index.Close()
+
+	accounts := []proto.Account{
+		{
+			Id:              "einstein",
+			AccountEnabled:  true,
+			UidNumber:       20000,
+			CreatedDateTime: &timestamp.Timestamp{Seconds: 1600000000},
+			PasswordProfile: &proto.PasswordProfile{Password: "relativity"},
+		},
+		{
+			Id:              "marie",
+			AccountEnabled:  false,
+			UidNumber:       20001,
+			CreatedDateTime: &timestamp.Timestamp{Seconds: 1600001000},
+			PasswordProfile: &proto.PasswordProfile{Password: "radioactivity", ForceChangePasswordNextSignIn: true},
+		},
+	}
+	for i := range accounts {
+		assert.NoError(t, index.Index(accounts[i].Id, &proto.BleveAccount{Account: accounts[i], BleveType: "account"}))
+	}
+
+	minUID, incl := float64(20001), true
+	uq := bleve.NewNumericRangeInclusiveQuery(&minUID, nil, &incl, nil)
+	uq.SetField("uid_number")
+
+	after := float64(1600000500)
+	cq := bleve.NewNumericRangeInclusiveQuery(&after, nil, &incl, nil)
+	cq.SetField("created_date_time.seconds")
+
+	eq := bleve.NewBoolFieldQuery(true)
+	eq.SetField("account_enabled")
+
+	fq := bleve.NewBoolFieldQuery(true)
+	fq.SetField("password_profile.force_change_password_next_sign_in")
+
+	pq := bleve.NewMatchQuery("relativity")
+	pq.SetField("password_profile.password")
+
+	odata := func(filter string) query.Query {
+		tree, err := godata.ParseFilterString(filter)
+		assert.NoError(t, err)
+		q, err := provider.BuildBleveQuery(tree)
+		assert.NoError(t, err)
+		return q
+	}
+
+	var scenarios = []struct {
+		name     string
+		query    query.Query
+		expected []string
+	}{
+		{"numeric", uq, []string{"marie"}},
+		{"timestamp", cq, []string{"marie"}},
+		{"boolean", eq, []string{"einstein"}},
+		{"nested boolean", fq, []string{"marie"}},
+		{"odata date time", odata("created_date_time gt 2020-09-13T12:30:00Z"), []string{"marie"}},
+		{"odata boolean", odata("account_enabled eq false and uid_number ge 20001"), []string{"marie"}},
+		{"password is not indexed", pq, []string{}},
+		{"password is not in _all", bleve.NewMatchQuery("relativity"), []string{}},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			result, err := index.Search(bleve.NewSearchRequest(scenario.query))
+			assert.NoError(t, err)
+			ids := []string{}
+			for _, hit := range result.Hits {
+				ids = append(ids, hit.ID)
+			}
+			assert.ElementsMatch(t, scenario.expected, ids)
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/provider"
+)
+
+// defaults for the query limits, used when the config does not set them
+const (
+	defaultMaxQueryDepth   = 16
+	defaultMaxQueryClauses = 1024
+	defaultMaxResultWindow = 10000
+	defaultQueryTimeout    = 10 * time.Second
+)
+
+func (s Service) maxQueryDepth() int {
+	if s.Config.Search.MaxQueryDepth > 0 {
+		return s.Config.Search.MaxQueryDepth
+	}
+	return defaultMaxQueryDepth
+}
+
+func (s Service) maxQueryClauses() int {
+	if s.Config.Search.MaxQueryClauses > 0 {
+		return s.Config.Search.MaxQueryClauses
+	}
+	return defaultMaxQueryClauses
+}
+
+func (s Service) maxResultWindow() int {
+	if s.Config.Search.MaxResultWindow > 0 {
+		return s.Config.Search.MaxResultWindow
+	}
+	return defaultMaxResultWindow
+}
+
+func (s Service) queryTimeout() time.Duration {
+	if s.Config.Search.QueryTimeout > 0 {
+		return s.Config.Search.QueryTimeout
+	}
+	return defaultQueryTimeout
+}
+
+// checkQueryLimits rejects queries that are nested too deep or have too many clauses
+func (s Service) checkQueryLimits(q query.Query) error {
+	depth, clauses := provider.QueryComplexity(q)
+	if max := s.maxQueryDepth(); depth > max {
+		return merrors.BadRequest(s.id, "query is nested %d levels deep, the limit is %d", depth, max)
+	}
+	if max := s.maxQueryClauses(); clauses > max {
+		return merrors.BadRequest(s.id, "query has %d clauses, the limit is %d", clauses, max)
+	}
+	return nil
+}
+
+// checkResultWindow rejects pages beyond the result window, clients have to refine their query instead
+func (s Service) checkResultWindow(offset int, size int) error {
+	if max := s.maxResultWindow(); offset+size > max {
+		return merrors.BadRequest(s.id, "the page exceeds the result window of %d records, refine the query", max)
+	}
+	return nil
+}
+
+// search executes a search in the index. The search is aborted when the query timeout is exceeded or ctx is done.
+func (s Service) search(ctx context.Context, searchRequest *bleve.SearchRequest) (*bleve.SearchResult, error) {
+	timeout := s.queryTimeout()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	searchResult, err := s.index.SearchInContext(ctx, searchRequest)
+	if err != nil {
+		switch ctx.Err() {
+		case context.DeadlineExceeded:
+			s.log.Error().Err(err).Interface("query", searchRequest.Query).Dur("timeout", timeout).Msg("query timed out")
+			return nil, merrors.Timeout(s.id, "query did not finish within %s", timeout)
+		case context.Canceled:
+			return nil, merrors.Timeout(s.id, "query was canceled")
+		}
+		s.log.Error().Err(err).Msg("could not execute bleve search")
+		return nil, merrors.InternalServerError(s.id, "could not execute bleve search: %v", err.Error())
+	}
+	return searchResult, nil
+}
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaults for the lockout durations, used when the config does not set them
+const (
+	defaultLockoutDuration    = time.Minute
+	defaultMaxLockoutDuration = time.Hour
+)
+
+// _maxTrackedSources is the number of client addresses after which addresses without recent failures are forgotten
+const _maxTrackedSources = 10000
+
+func (s Service) lockoutDuration() time.Duration {
+	if s.Config.Auth.LockoutDuration > 0 {
+		return s.Config.Auth.LockoutDuration
+	}
+	return defaultLockoutDuration
+}
+
+func (s Service) maxLockoutDuration() time.Duration {
+	if s.Config.Auth.MaxLockoutDuration > 0 {
+		return s.Config.Auth.MaxLockoutDuration
+	}
+	return defaultMaxLockoutDuration
+}
+
+// lockFor returns how long to lock after the given number of failures. The duration doubles with every failure above
+// the threshold.
+func (s Service) lockFor(failures int, threshold int) time.Duration {
+	d, max := s.lockoutDuration(), s.maxLockoutDuration()
+	for i := threshold; i < failures && d < max; i++ {
+		d *= 2
+	}
+	if d > max {
+		d = max
+	}
+	return d
+}
+
+// accountLocked returns true if the account is locked because of too many failed sign-in attempts
+func accountLocked(a *proto.Account, now time.Time) bool {
+	return a.LockedUntilDateTime != nil && now.Before(a.LockedUntilDateTime.AsTime())
+}
+
+// recordFailedSignIn counts a failed sign-in attempt of the account and locks it when the threshold is reached
+func (s Service) recordFailedSignIn(a *proto.Account, now time.Time) {
+	a.FailedSignInAttempts++
+	if threshold := s.Config.Auth.LockoutThreshold; threshold > 0 && int(a.FailedSignInAttempts) >= threshold {
+		until := now.Add(s.lockFor(int(a.FailedSignInAttempts), threshold))
+		a.LockedUntilDateTime = timestamppb.New(until)
+		s.log.Warn().Str("id", a.Id).Int32("attempts", a.FailedSignInAttempts).Time("until", until).Msg("account locked")
+	}
+}
+
+// resetFailedSignIns unlocks the account, it returns false if the account was not locked and had no failed attempts
+func resetFailedSignIns(a *proto.Account) bool {
+	if a.FailedSignInAttempts == 0 && a.LockedUntilDateTime == nil {
+		return false
+	}
+	a.FailedSignInAttempts = 0
+	a.LockedUntilDateTime = nil
+	return true
+}
+
+// clientAddress returns the address of the client. It is the address of the direct peer, which the grpc server of
+// go-micro and the http server set from the connection. Clients can send any X-Forwarded-For and X-Real-Ip headers,
+// so they are only used when the direct peer is a trusted proxy. Then the last address in X-Forwarded-For that is not
+// a trusted proxy is the client.
+func (s Service) clientAddress(ctx context.Context) string {
+	md, _ := metadata.FromContext(ctx)
+	var direct string
+	if p, ok := peer.FromContext(ctx); ok {
+		direct = hostOf(p.Addr.String())
+	} else if v, ok := md.Get("Remote"); ok {
+		direct = hostOf(strings.TrimSpace(v))
+	}
+	if direct == "" || !s.trustedProxy(direct) {
+		return direct
+	}
+
+	if v, ok := md.Get("X-Forwarded-For"); ok && v != "" {
+		hops := strings.Split(v, ",")
+		client := direct
+		for i := len(hops) - 1; i >= 0; i-- {
+			hop := hostOf(strings.TrimSpace(hops[i]))
+			if hop == "" {
+				continue
+			}
+			client = hop
+			if !s.trustedProxy(hop) {
+				break
+			}
+		}
+		return client
+	}
+	if v, ok := md.Get("X-Real-Ip"); ok && v != "" {
+		return hostOf(strings.TrimSpace(v))
+	}
+	return direct
+}
+
+// trustedProxy returns true if the address belongs to one of the trusted proxies
+func (s Service) trustedProxy(addr string) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, n := range s.trustedProxies {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// parseTrustedProxies parses a comma separated list of addresses and networks in CIDR notation
+func parseTrustedProxies(proxies string) ([]*net.IPNet, error) {
+	var nets []*net.IPNet
+	for _, p := range strings.Split(proxies, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if !strings.Contains(p, "/") {
+			ip := net.ParseIP(p)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy %s", p)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			nets = append(nets, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, n, err := net.ParseCIDR(p)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %s: %v", p, err)
+		}
+		nets = append(nets, n)
+	}
+	return nets, nil
+}
+
+// hostOf strips the port from an address
+func hostOf(addr string) string {
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		return host
+	}
+	return addr
+}
+
+// sourceTracker counts the failed sign-in attempts per client address. It is kept in memory, so a restart unlocks
+// all addresses.
+type sourceTracker struct {
+	mu      sync.Mutex
+	sources map[string]*sourceFailures
+}
+
+type sourceFailures struct {
+	count       int
+	last        time.Time
+	lockedUntil time.Time
+}
+
+func newSourceTracker() *sourceTracker {
+	return &sourceTracker{sources: map[string]*sourceFailures{}}
+}
+
+// sourceLocked returns true if the client address is locked
+func (s Service) sourceLocked(source string, now time.Time) bool {
+	if s.sources == nil || source == "" || s.Config.Auth.SourceLockoutThreshold <= 0 {
+		return false
+	}
+	s.sources.mu.Lock()
+	defer s.sources.mu.Unlock()
+	f, ok := s.sources.sources[source]
+	return ok && now.Before(f.lockedUntil)
+}
+
+// recordFailedSource counts a failed sign-in attempt from the client address and locks it when the threshold is
+// reached. Failures are forgotten when there was no failure for the maximum lockout duration.
+func (s Service) recordFailedSource(source string, now time.Time) {
+	threshold := s.Config.Auth.SourceLockoutThreshold
+	if s.sources == nil || source == "" || threshold <= 0 {
+		return
+	}
+	max := s.maxLockoutDuration()
+
+	s.sources.mu.Lock()
+	defer s.sources.mu.Unlock()
+	if len(s.sources.sources) >= _maxTrackedSources {
+		for k, f := range s.sources.sources {
+			if now.Sub(f.last) > max && now.After(f.lockedUntil) {
+				delete(s.sources.sources, k)
+			}
+		}
+	}
+	f, ok := s.sources.sources[source]
+	if !ok || now.Sub(f.last) > max {
+		f = &sourceFailures{}
+		s.sources.sources[source] = f
+	}
+	f.count++
+	f.last = now
+	if f.count >= threshold {
+		f.lockedUntil = now.Add(s.lockFor(f.count, threshold))
+		s.log.Warn().Str("source", source).Int("attempts", f.count).Time("until", f.lockedUntil).Msg("client address locked")
+	}
+}
+
+// UnlockAccount implements the AccountsServiceHandler interface
+func (s Service) UnlockAccount(ctx context.Context, in *proto.UnlockAccountRequest, out *proto.Account) (err error) {
+	if !s.hasAccountManagementPermissions(ctx) {
+		return merrors.Forbidden(s.id, "no permission for UnlockAccount")
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	var id string
+	if id, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	if err = s.loadAccount(id, out); err != nil {
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+
+	if resetFailedSignIns(out) {
+		if err = s.writeAccount(out); err != nil {
+			s.log.Error().Err(err).Str("id", out.Id).Msg("could not persist unlocked account")
+			return
+		}
+		if err = s.indexAccount(id); err != nil {
+			return merrors.InternalServerError(s.id, "could not index unlocked account: %v", err.Error())
+		}
+		s.log.Info().Str("id", id).Msg("unlocked account")
+	}
+
+	// remove passwords
+	removeSecrets(out)
+	return
+}
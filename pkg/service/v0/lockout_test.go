@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLockFor(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.LockoutDuration = time.Minute
+	svc.Config.Auth.MaxLockoutDuration = 4 * time.Minute
+
+	assert.Equal(t, time.Minute, svc.lockFor(3, 3))
+	assert.Equal(t, 2*time.Minute, svc.lockFor(4, 3))
+	assert.Equal(t, 4*time.Minute, svc.lockFor(5, 3))
+	assert.Equal(t, 4*time.Minute, svc.lockFor(6, 3))
+}
+
+func TestAccountLockout(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.LockoutThreshold = 3
+
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	authenticate := func(password string) proto.AuthenticationFailure {
+		out := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: "einstein", Password: password}, out))
+		return out.Failure
+	}
+
+	for i := 0; i < 3; i++ {
+		assert.Equal(t, proto.AuthenticationFailure_INVALID_PASSWORD, authenticate("quantum"))
+	}
+	assert.Equal(t, proto.AuthenticationFailure_ACCOUNT_LOCKED, authenticate("relativity"), "the password is not checked for locked accounts")
+
+	locked := &proto.Account{}
+	assert.NoError(t, svc.GetAccount(context.Background(), &proto.GetAccountRequest{Id: "einstein"}, locked))
+	assert.Equal(t, int32(3), locked.FailedSignInAttempts)
+	if assert.NotNil(t, locked.LockedUntilDateTime) {
+		assert.True(t, locked.LockedUntilDateTime.AsTime().After(time.Now()))
+	}
+
+	unlocked := &proto.Account{}
+	assert.NoError(t, svc.UnlockAccount(context.Background(), &proto.UnlockAccountRequest{Id: "einstein"}, unlocked))
+	assert.Zero(t, unlocked.FailedSignInAttempts)
+	assert.Nil(t, unlocked.LockedUntilDateTime)
+	assert.Empty(t, unlocked.PasswordProfile.Password)
+
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_PASSWORD, authenticate("quantum"))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate("relativity"))
+
+	// a successful sign-in resets the failed attempts
+	stored := &proto.Account{}
+	assert.NoError(t, svc.loadAccount("einstein", stored))
+	assert.Zero(t, stored.FailedSignInAttempts)
+}
+
+func TestSourceLockout(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.SourceLockoutThreshold = 2
+
+	attacker := metadata.Set(context.Background(), "Remote", "10.0.0.1:4711")
+	other := metadata.Set(context.Background(), "Remote", "10.0.0.2:4711")
+	authenticate := func(ctx context.Context, login string) proto.AuthenticationFailure {
+		out := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(ctx, &proto.AuthenticateAccountRequest{Login: login, Password: "secret"}, out))
+		return out.Failure
+	}
+
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(attacker, "bohr"))
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(attacker, "heisenberg"))
+	assert.Equal(t, proto.AuthenticationFailure_SOURCE_LOCKED, authenticate(attacker, "planck"))
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(other, "planck"))
+
+	// forwarded headers from untrusted clients are ignored, so changing them does not escape the lockout
+	spoofed := metadata.Set(attacker, "X-Forwarded-For", "192.0.2.1")
+	assert.Equal(t, proto.AuthenticationFailure_SOURCE_LOCKED, authenticate(spoofed, "planck"))
+	framing := metadata.Set(other, "X-Forwarded-For", "10.0.0.3")
+	authenticate(framing, "planck")
+	authenticate(framing, "planck")
+	victim := metadata.Set(context.Background(), "Remote", "10.0.0.3:4711")
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(victim, "planck"), "clients cannot lock other addresses")
+}
+
+func TestSourceLockoutBehindProxies(t *testing.T) {
+	cfg := config.New()
+	cfg.Auth.SourceLockoutThreshold = 2
+	_, err := New(Config(cfg))
+	assert.Error(t, err, "without trusted proxies the address of the proxy would be locked for every user")
+
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.SourceLockoutThreshold = 2
+	svc.trustedProxies, err = parseTrustedProxies("127.0.0.1")
+	assert.NoError(t, err)
+
+	proxy := metadata.Set(context.Background(), "Remote", "127.0.0.1:4711")
+	attacker := metadata.Set(proxy, "X-Forwarded-For", "192.0.2.1")
+	user := metadata.Set(proxy, "X-Forwarded-For", "192.0.2.2")
+	authenticate := func(ctx context.Context) proto.AuthenticationFailure {
+		out := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(ctx, &proto.AuthenticateAccountRequest{Login: "bohr", Password: "secret"}, out))
+		return out.Failure
+	}
+
+	authenticate(attacker)
+	authenticate(attacker)
+	assert.Equal(t, proto.AuthenticationFailure_SOURCE_LOCKED, authenticate(attacker))
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(user), "other clients of the proxy are not locked")
+	assert.Equal(t, proto.AuthenticationFailure_UNKNOWN_ACCOUNT, authenticate(proxy), "the proxy itself is not locked")
+}
+
+func TestClientAddress(t *testing.T) {
+	svc := Service{}
+	var err error
+	svc.trustedProxies, err = parseTrustedProxies("10.0.0.1, 10.1.0.0/16")
+	assert.NoError(t, err)
+
+	ctx := func(kv ...string) context.Context {
+		c := context.Background()
+		for i := 0; i < len(kv); i += 2 {
+			c = metadata.Set(c, kv[i], kv[i+1])
+		}
+		return c
+	}
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"no metadata", context.Background(), ""},
+		{"direct client", ctx("Remote", "192.0.2.1:4711"), "192.0.2.1"},
+		{"spoofed header", ctx("Remote", "192.0.2.1:4711", "X-Forwarded-For", "198.51.100.1"), "192.0.2.1"},
+		{"header without peer", ctx("X-Forwarded-For", "198.51.100.1"), ""},
+		{"trusted proxy", ctx("Remote", "10.0.0.1:4711", "X-Forwarded-For", "198.51.100.1"), "198.51.100.1"},
+		{"trusted proxy chain", ctx("Remote", "10.0.0.1:4711", "X-Forwarded-For", "203.0.113.1, 198.51.100.1, 10.1.2.3"), "198.51.100.1"},
+		{"real ip from trusted proxy", ctx("Remote", "10.0.0.1:4711", "X-Real-Ip", "198.51.100.1"), "198.51.100.1"},
+		{"trusted proxy without header", ctx("Remote", "10.0.0.1:4711"), "10.0.0.1"},
+	} {
+		assert.Equal(t, tc.want, svc.clientAddress(tc.ctx), tc.name)
+	}
+
+	_, err = parseTrustedProxies("proxy")
+	assert.Error(t, err)
+}
@@ -0,0 +1,295 @@
+package service
+
+import (
+	"context"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// defaultMaxNestingDepth is used when the config does not set a maximum nesting depth for groups
+const defaultMaxNestingDepth = 10
+
+func (s Service) maxNestingDepth() int {
+	if s.Config.Groups.MaxNestingDepth > 0 {
+		return s.Config.Groups.MaxNestingDepth
+	}
+	return defaultMaxNestingDepth
+}
+
+// walkGroups loads the groups reachable from the groups with the given ids by following next, level by level. Every
+// group is visited only once, so cycles end the traversal. A maxLevels > 0 stops the traversal after that many levels
+// below the start groups. It returns the visited groups in the order they were reached, including the start groups,
+// and the number of levels below the start groups that contain groups.
+func (s Service) walkGroups(ids []string, next func(g *proto.Group) []*proto.Group, maxLevels int) ([]*proto.Group, int) {
+	visited := map[string]struct{}{}
+	groups := []*proto.Group{}
+	levels := -1
+	for len(ids) > 0 {
+		if maxLevels > 0 && levels == maxLevels {
+			s.log.Warn().Strs("groups", ids).Int("max", maxLevels).Msg("groups are nested too deep, skipping")
+			break
+		}
+		loaded := s.loadGroups(ids)
+		nextIDs := []string{}
+		added := false
+		for _, id := range ids {
+			g, ok := loaded[id]
+			if !ok {
+				continue
+			}
+			if _, ok := visited[id]; ok {
+				continue
+			}
+			visited[id] = struct{}{}
+			groups = append(groups, g)
+			added = true
+			for _, n := range next(g) {
+				if _, ok := visited[n.Id]; !ok {
+					nextIDs = append(nextIDs, n.Id)
+				}
+			}
+		}
+		if added {
+			levels++
+		}
+		ids = nextIDs
+	}
+	if levels < 0 {
+		levels = 0
+	}
+	return groups, levels
+}
+
+func memberGroupsOf(g *proto.Group) []*proto.Group { return g.MemberGroups }
+
+func parentGroupsOf(g *proto.Group) []*proto.Group { return g.MemberOf }
+
+// transitiveMemberOf returns the groups the account is a member of, directly or through nested groups. Members are
+// hidden in the returned groups.
+func (s Service) transitiveMemberOf(a *proto.Account) []*proto.Group {
+	ids := make([]string, 0, len(a.MemberOf))
+	for i := range a.MemberOf {
+		ids = append(ids, a.MemberOf[i].Id)
+	}
+	groups, _ := s.walkGroups(ids, parentGroupsOf, s.maxNestingDepth())
+	expanded := make([]*proto.Group, 0, len(groups))
+	for _, g := range groups {
+		c := *g
+		c.Members = nil // always hide members when expanding
+		expanded = append(expanded, &c)
+	}
+	return expanded
+}
+
+// hasGroup returns true if a group with the given id is in groups
+func hasGroup(groups []*proto.Group, id string) bool {
+	for i := range groups {
+		if groups[i].Id == id {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutGroup returns the groups without the group with the given id
+func withoutGroup(groups []*proto.Group, id string) []*proto.Group {
+	filtered := []*proto.Group{}
+	for i := range groups {
+		if groups[i].Id != id {
+			filtered = append(filtered, groups[i])
+		}
+	}
+	return filtered
+}
+
+// deflateGroups replaces the groups related to the group with the given id with instances that only contain the id
+func (s Service) deflateGroups(id string, groups []*proto.Group) []*proto.Group {
+	deflated := []*proto.Group{}
+	for i := range groups {
+		if groups[i].Id != "" {
+			deflated = append(deflated, &proto.Group{Id: groups[i].Id})
+		} else {
+			// TODO fetch and use an id when group only has a name but no id
+			s.log.Error().Str("id", id).Interface("group", groups[i]).Msg("resolving groups by name is not implemented yet")
+		}
+	}
+	return deflated
+}
+
+// writeGroupRelation persists and indexes both groups of a nesting relation
+func (s Service) writeGroupRelation(g *proto.Group, m *proto.Group) (err error) {
+	if err = s.writeGroup(g); err != nil {
+		s.log.Error().Err(err).Interface("group", g).Msg("could not persist group")
+		return
+	}
+	if err = s.writeGroup(m); err != nil {
+		s.log.Error().Err(err).Interface("group", m).Msg("could not persist member group")
+		return
+	}
+	if err = s.indexGroup(g.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index group: %v", err.Error())
+	}
+	if err = s.indexGroup(m.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not index member group: %v", err.Error())
+	}
+	// TODO rollback changes when only one of them failed?
+	return nil
+}
+
+// AddMemberGroup implements the GroupsServiceHandler interface
+func (s Service) AddMemberGroup(c context.Context, in *proto.AddMemberGroupRequest, out *proto.Group) (err error) {
+
+	// cleanup ids
+	var groupID string
+	if groupID, err = cleanupID(in.GroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	var memberID string
+	if memberID, err = cleanupID(in.MemberGroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up member group id: %v", err.Error())
+	}
+
+	if groupID == memberID {
+		return merrors.BadRequest(s.id, "group %s can not be a member of itself", groupID)
+	}
+
+	// load structs
+	g := &proto.Group{}
+	if err = s.loadGroup(groupID, g); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+
+	m := &proto.Group{}
+	if err = s.loadGroup(memberID, m); err != nil {
+		s.log.Error().Err(err).Str("id", memberID).Msg("could not load member group")
+		return
+	}
+
+	if !hasGroup(g.MemberGroups, m.Id) {
+		// the group must not be nested in the member group, otherwise the groups would form a cycle
+		subgroups, below := s.walkGroups([]string{m.Id}, memberGroupsOf, 0)
+		if hasGroup(subgroups, g.Id) {
+			return merrors.Conflict(s.id, "group %s is a member of group %s, adding it would create a cycle", g.Id, m.Id)
+		}
+		_, above := s.walkGroups([]string{g.Id}, parentGroupsOf, 0)
+		if max := s.maxNestingDepth(); above+1+below > max {
+			return merrors.BadRequest(s.id, "groups would be nested %d levels deep, the limit is %d", above+1+below, max)
+		}
+		g.MemberGroups = append(g.MemberGroups, m)
+	}
+	if !hasGroup(m.MemberOf, g.Id) {
+		m.MemberOf = append(m.MemberOf, g)
+	}
+
+	return s.writeGroupRelation(g, m)
+}
+
+// RemoveMemberGroup implements the GroupsServiceHandler interface
+func (s Service) RemoveMemberGroup(c context.Context, in *proto.RemoveMemberGroupRequest, out *proto.Group) (err error) {
+
+	// cleanup ids
+	var groupID string
+	if groupID, err = cleanupID(in.GroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	var memberID string
+	if memberID, err = cleanupID(in.MemberGroupId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up member group id: %v", err.Error())
+	}
+
+	// load structs
+	g := &proto.Group{}
+	if err = s.loadGroup(groupID, g); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+
+	m := &proto.Group{}
+	if err = s.loadGroup(memberID, m); err != nil {
+		s.log.Error().Err(err).Str("id", memberID).Msg("could not load member group")
+		return
+	}
+
+	g.MemberGroups = withoutGroup(g.MemberGroups, m.Id)
+	m.MemberOf = withoutGroup(m.MemberOf, g.Id)
+
+	return s.writeGroupRelation(g, m)
+}
+
+// ListTransitiveMembers implements the GroupsServiceHandler interface
+func (s Service) ListTransitiveMembers(c context.Context, in *proto.ListTransitiveMembersRequest, out *proto.ListTransitiveMembersResponse) (err error) {
+
+	// cleanup ids
+	var groupID string
+	if groupID, err = cleanupID(in.Id); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up group id: %v", err.Error())
+	}
+
+	g := &proto.Group{}
+	if err = s.loadGroup(groupID, g); err != nil {
+		s.log.Error().Err(err).Str("id", groupID).Msg("could not load group")
+		return
+	}
+
+	mask, err := s.readMask(in.FieldMask)
+	if err != nil {
+		return err
+	}
+
+	groups, _ := s.walkGroups([]string{g.Id}, memberGroupsOf, s.maxNestingDepth())
+	ids := []string{}
+	seen := map[string]struct{}{}
+	for _, g := range groups {
+		for i := range g.Members {
+			if _, ok := seen[g.Members[i].Id]; !ok {
+				seen[g.Members[i].Id] = struct{}{}
+				ids = append(ids, g.Members[i].Id)
+			}
+		}
+	}
+	accounts := s.loadAccounts(ids)
+
+	members := make([]*proto.Account, 0, len(ids))
+	for _, id := range ids {
+		if a, ok := accounts[id]; ok {
+			members = append(members, a)
+		}
+	}
+
+	if expansionRequested(mask, "MemberOf") {
+		s.expandAccountsMemberOf(members)
+	}
+
+	out.Members = make([]*proto.Account, 0, len(members))
+	for _, a := range members {
+		if a, err = s.projectAccount(mask, a); err != nil {
+			return err
+		}
+
+		out.Members = append(out.Members, a)
+	}
+	return nil
+}
+
+// ListTransitiveMemberOf implements the GroupsServiceHandler interface
+func (s Service) ListTransitiveMemberOf(c context.Context, in *proto.ListTransitiveMemberOfRequest, out *proto.ListTransitiveMemberOfResponse) (err error) {
+
+	// cleanup ids
+	var accountID string
+	if accountID, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	a := &proto.Account{}
+	if err = s.loadAccount(accountID, a); err != nil {
+		s.log.Error().Err(err).Str("id", accountID).Msg("could not load account")
+		return
+	}
+
+	out.Groups = s.transitiveMemberOf(a)
+	return nil
+}
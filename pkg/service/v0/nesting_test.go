@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNestedGroups(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Groups.MaxNestingDepth = 2
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		addTestGroup(t, svc, &proto.Group{Id: fmt.Sprintf("group-%d", i)})
+	}
+	addTestGroup(t, svc, &proto.Group{Id: "group-3", Members: []*proto.Account{{Id: "marie"}}})
+	addTestAccount(t, svc, einstein(), "")
+	addTestAccount(t, svc, &proto.Account{Id: "marie", AccountEnabled: true, MemberOf: []*proto.Group{{Id: "group-3"}}}, "")
+
+	addMemberGroup := func(groupID, memberID string) error {
+		return svc.AddMemberGroup(ctx, &proto.AddMemberGroupRequest{GroupId: groupID, MemberGroupId: memberID}, &proto.Group{})
+	}
+	transitiveMembers := func(groupID string) []string {
+		out := &proto.ListTransitiveMembersResponse{}
+		assert.NoError(t, svc.ListTransitiveMembers(ctx, &proto.ListTransitiveMembersRequest{Id: groupID}, out))
+		ids := []string{}
+		for _, a := range out.Members {
+			assert.Empty(t, a.PasswordProfile.Password)
+			ids = append(ids, a.Id)
+		}
+		return ids
+	}
+
+	// group-0 > group-1 > group-2
+	assert.NoError(t, addMemberGroup("group-0", "group-1"))
+	assert.NoError(t, addMemberGroup("group-1", "group-2"))
+	assert.NoError(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: "group-0", AccountId: "einstein"}, &proto.Group{}))
+	assert.NoError(t, svc.AddMember(ctx, &proto.AddMemberRequest{GroupId: "group-2", AccountId: "marie"}, &proto.Group{}))
+
+	assert.Error(t, addMemberGroup("group-0", "group-0"), "a group can not be a member of itself")
+	assert.Error(t, addMemberGroup("group-2", "group-0"), "cycles are rejected")
+	assert.Error(t, addMemberGroup("group-2", "group-3"), "the nesting depth is limited")
+
+	assert.ElementsMatch(t, []string{"einstein", "marie"}, transitiveMembers("group-0"))
+	assert.ElementsMatch(t, []string{"marie"}, transitiveMembers("group-1"))
+
+	memberOf := &proto.ListTransitiveMemberOfResponse{}
+	assert.NoError(t, svc.ListTransitiveMemberOf(ctx, &proto.ListTransitiveMemberOfRequest{AccountId: "marie"}, memberOf))
+	ids := []string{}
+	for _, g := range memberOf.Groups {
+		assert.Empty(t, g.Members)
+		ids = append(ids, g.Id)
+	}
+	assert.ElementsMatch(t, []string{"group-0", "group-1", "group-2", "group-3"}, ids)
+
+	// memberOf only contains the effective groups when configured
+	direct := &proto.Account{}
+	assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "marie"}, direct))
+	assert.Len(t, direct.MemberOf, 2)
+	svc.Config.Groups.TransitiveMemberOf = true
+	effective := &proto.Account{}
+	assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "marie"}, effective))
+	assert.Len(t, effective.MemberOf, 4)
+
+	assert.NoError(t, svc.RemoveMemberGroup(ctx, &proto.RemoveMemberGroupRequest{GroupId: "group-1", MemberGroupId: "group-2"}, &proto.Group{}))
+	assert.ElementsMatch(t, []string{"einstein"}, transitiveMembers("group-0"))
+	assert.NoError(t, addMemberGroup("group-2", "group-0"), "groups can be nested the other way round after removing the relation")
+}
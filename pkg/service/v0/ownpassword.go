@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+)
+
+// ChangeOwnPassword implements the AccountsServiceHandler interface
+func (s Service) ChangeOwnPassword(ctx context.Context, in *proto.ChangeOwnPasswordRequest, out *proto.Account) (err error) {
+	if in.CurrentPassword == "" {
+		return merrors.BadRequest(s.id, "current password must not be empty")
+	}
+	if in.NewPassword == "" {
+		return merrors.BadRequest(s.id, "new password must not be empty")
+	}
+	accountID, ok := metadata.Get(ctx, middleware.AccountID)
+	if !ok || accountID == "" {
+		return merrors.Unauthorized(s.id, "no authenticated account")
+	}
+
+	var id string
+	if id, err = cleanupID(accountID); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	// the current password and second factor protect against stolen sessions, they are checked like a sign-in. Users
+	// that have to change their password can still do it.
+	accLock.Lock()
+	if err = s.loadAccount(id, out); err != nil {
+		accLock.Unlock()
+		s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+		return
+	}
+	err = s.reauthenticate(ctx, out, in.CurrentPassword, in.MfaCode)
+	accLock.Unlock()
+	if err != nil {
+		return
+	}
+
+	// comparing the new password with the history is slow, it must not block other requests
+	if err = s.checkPasswordPolicy(out, in.NewPassword); err != nil {
+		return err
+	}
+	checked, err := s.checkPasswordReuse(out.GetPasswordProfile().GetPassword(), out.GetPasswordProfile().GetPasswordHistory(), in.NewPassword, nil)
+	if err != nil {
+		return err
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+	if err = s.reloadForPasswordChange(out); err != nil {
+		return err
+	}
+	if err = s.setPassword(out, in.NewPassword, time.Now(), checked); err != nil {
+		return err
+	}
+	if err = s.persistAccount(out); err != nil {
+		return
+	}
+	s.log.Info().Str("id", out.Id).Msg("changed own password")
+
+	// remove passwords
+	removeSecrets(out)
+	return nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/mfa"
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangeOwnPassword(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.policy = &password.Policy{MinLength: 8}
+	svc.Config.Auth.LockoutThreshold = 3
+
+	addTestAccount(t, svc, &proto.Account{Id: "curie", AccountEnabled: true, OnPremisesSamAccountName: "curie"}, "relativity")
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, "curie")
+	changeOwnPassword := func(ctx context.Context, current, new string) (*proto.Account, error) {
+		out := &proto.Account{}
+		err := svc.ChangeOwnPassword(ctx, &proto.ChangeOwnPasswordRequest{CurrentPassword: current, NewPassword: new}, out)
+		return out, err
+	}
+
+	_, err := changeOwnPassword(context.Background(), "relativity", "radioactivity")
+	assert.Error(t, err, "the caller has to be authenticated")
+	_, err = changeOwnPassword(ctx, "quantum", "radioactivity")
+	assert.Error(t, err)
+	_, err = changeOwnPassword(ctx, "relativity", "radium")
+	assert.Error(t, err, "the new password has to meet the password policy")
+
+	out, err := changeOwnPassword(ctx, "relativity", "radioactivity")
+	assert.NoError(t, err)
+	assert.Empty(t, out.PasswordProfile.Password)
+	assert.NotNil(t, out.PasswordProfile.LastPasswordChangeDateTime)
+	assert.NotNil(t, out.SignInSessionsValidFromDateTime)
+	assert.Equal(t, int32(0), out.FailedSignInAttempts, "the failed attempt is reset")
+
+	auth := &proto.AuthenticateAccountResponse{}
+	assert.NoError(t, svc.AuthenticateAccount(context.Background(), &proto.AuthenticateAccountRequest{Login: "curie", Password: "radioactivity"}, auth))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, auth.Failure)
+
+	for i := 0; i < 3; i++ {
+		_, err = changeOwnPassword(ctx, "quantum", "polonium-1898")
+		assert.Error(t, err)
+	}
+	_, err = changeOwnPassword(ctx, "radioactivity", "polonium-1898")
+	assert.Error(t, err, "guessing the current password locks the account")
+}
+
+func TestChangeOwnPasswordChecksLikeSignIn(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.SourceLockoutThreshold = 2
+	var err error
+	svc.secrets, err = mfa.NewCipher([]byte("test key"))
+	assert.NoError(t, err)
+
+	addTestAccount(t, svc, &proto.Account{Id: "curie", AccountEnabled: true, OnPremisesSamAccountName: "curie"}, "relativity")
+
+	// admins can enroll TOTP for the account without its password
+	enrollment := &proto.EnrollTotpResponse{}
+	assert.NoError(t, svc.EnrollTotp(context.Background(), &proto.EnrollTotpRequest{AccountId: "curie"}, enrollment))
+	secret, err := mfa.DecodeSecret(enrollment.Secret)
+	assert.NoError(t, err)
+	now := time.Now()
+	assert.NoError(t, svc.VerifyTotp(context.Background(), &proto.VerifyTotpRequest{AccountId: "curie", Code: mfa.Code(secret, mfa.Step(now))}, &proto.VerifyTotpResponse{}))
+
+	user := metadata.Set(context.Background(), middleware.AccountID, "curie")
+	attacker := metadata.Set(user, "Remote", "10.0.0.1:4711")
+	victim := metadata.Set(user, "Remote", "10.0.0.2:4711")
+	changeOwnPassword := func(ctx context.Context, current, code string) error {
+		return svc.ChangeOwnPassword(ctx, &proto.ChangeOwnPasswordRequest{CurrentPassword: current, NewPassword: "radioactivity", MfaCode: code}, &proto.Account{})
+	}
+
+	assert.Error(t, changeOwnPassword(victim, "relativity", ""), "the second factor is required")
+	assert.Error(t, changeOwnPassword(victim, "relativity", "000000"))
+
+	assert.Error(t, changeOwnPassword(attacker, "quantum", ""))
+	assert.Error(t, changeOwnPassword(attacker, "gravity", ""))
+	assert.Error(t, changeOwnPassword(attacker, "relativity", mfa.Code(secret, mfa.Step(now)+1)), "failed attempts are counted per client address")
+
+	assert.NoError(t, changeOwnPassword(victim, "relativity", mfa.Code(secret, mfa.Step(now)+1)))
+	assert.Error(t, changeOwnPassword(victim, "radioactivity", mfa.Code(secret, mfa.Step(now)+1)), "codes cannot be replayed")
+}
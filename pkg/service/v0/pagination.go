@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/provider"
+)
+
+// defaultMaxPageSize is used when no maximum page size has been configured
+const defaultMaxPageSize = 1000
+
+// pageToken is the payload of the opaque page tokens handed out to clients
+type pageToken struct {
+	// After contains the sort values of the last hit of the previous page
+	After []string `json:"a"`
+	// Fingerprint identifies the request parameters the token was issued for
+	Fingerprint string `json:"f"`
+	// Offset is the number of records returned on the previous pages
+	Offset int `json:"o,omitempty"`
+}
+
+// newPageTokenKey returns the key used to sign page tokens. A random key is generated when no secret is configured,
+// which invalidates all tokens on restart.
+func newPageTokenKey(secret string) ([]byte, error) {
+	if secret != "" {
+		return []byte(secret), nil
+	}
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// fingerprint hashes the request parameters that influence the result set so a page token can only be used to
+// continue the listing it was issued for
+func fingerprint(params ...string) string {
+	h := sha256.Sum256([]byte(strings.Join(params, "\x00")))
+	return hex.EncodeToString(h[:8])
+}
+
+func (s Service) signPageToken(payload []byte) []byte {
+	mac := hmac.New(sha256.New, s.pageTokenKey)
+	mac.Write(payload)
+	return mac.Sum(nil)
+}
+
+func (s Service) encodePageToken(t *pageToken) (string, error) {
+	payload, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(payload) + "." + base64.RawURLEncoding.EncodeToString(s.signPageToken(payload)), nil
+}
+
+func (s Service) decodePageToken(token string, fp string) (*pageToken, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, merrors.BadRequest(s.id, "invalid page token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, merrors.BadRequest(s.id, "invalid page token")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(sig, s.signPageToken(payload)) {
+		return nil, merrors.BadRequest(s.id, "invalid page token")
+	}
+	t := &pageToken{}
+	if err = json.Unmarshal(payload, t); err != nil {
+		return nil, merrors.BadRequest(s.id, "invalid page token")
+	}
+	if t.Fingerprint != fp {
+		return nil, merrors.BadRequest(s.id, "page token does not match request")
+	}
+	return t, nil
+}
+
+func (s Service) maxPageSize() int {
+	if s.Config.Search.MaxPageSize > 0 {
+		return s.Config.Search.MaxPageSize
+	}
+	return defaultMaxPageSize
+}
+
+// pageSize validates the requested page size. 0 means as many records as allowed, bigger sizes are capped.
+func (s Service) pageSize(requested int32) (int, error) {
+	max := s.maxPageSize()
+	switch {
+	case requested < 0:
+		return 0, merrors.BadRequest(s.id, "page_size must not be negative")
+	case requested == 0, int(requested) > max:
+		return max, nil
+	}
+	return int(requested), nil
+}
+
+// sortOrder converts the order_by parameter of a list request into a bleve sort order. Without an order_by the
+// results are sorted by relevance if requested. The id is always added as the last sort key to get a stable order
+// across pages.
+func (s Service) sortOrder(orderBy string, sortable map[string]string, relevance bool) ([]string, error) {
+	order, err := provider.BuildBleveSortOrder(orderBy, sortable)
+	if err != nil {
+		return nil, merrors.BadRequest(s.id, "invalid order_by: %v", err.Error())
+	}
+	if len(order) == 0 && relevance {
+		order = append(order, "-_score")
+	}
+	if len(order) == 0 || (order[len(order)-1] != "_id" && order[len(order)-1] != "-_id") {
+		order = append(order, "_id")
+	}
+	return order, nil
+}
+
+// searchPage executes a bleve search for the page described by pageSize and token using the given sort order and
+// facets. The hits of the result are cut to the page size, the returned token is empty when there are no more results.
+// Queries exceeding the configured limits are rejected.
+func (s Service) searchPage(ctx context.Context, q query.Query, order []string, facets bleve.FacetsRequest, pageSize int32, token string, params ...string) (searchResult *bleve.SearchResult, nextPageToken string, err error) {
+	var size int
+	if size, err = s.pageSize(pageSize); err != nil {
+		return
+	}
+	if err = s.checkQueryLimits(q); err != nil {
+		return
+	}
+
+	fp := fingerprint(append(params, order...)...)
+
+	searchRequest := bleve.NewSearchRequest(q)
+	// fetch one more hit to determine if there is a next page
+	searchRequest.Size = size + 1
+	searchRequest.SortBy(order)
+	searchRequest.Facets = facets
+	searchRequest.Fields = s.sourceFields()
+	var offset int
+	if token != "" {
+		var t *pageToken
+		if t, err = s.decodePageToken(token, fp); err != nil {
+			return
+		}
+		searchRequest.SearchAfter = t.After
+		offset = t.Offset
+	}
+	if err = s.checkResultWindow(offset, size); err != nil {
+		return
+	}
+
+	if searchResult, err = s.search(ctx, searchRequest); err != nil {
+		return nil, "", err
+	}
+
+	s.log.Debug().Interface("result", searchResult).Msg("result")
+
+	if len(searchResult.Hits) > size {
+		searchResult.Hits = searchResult.Hits[:size]
+		if nextPageToken, err = s.encodePageToken(&pageToken{
+			After:       searchResult.Hits[size-1].Sort,
+			Fingerprint: fp,
+			Offset:      offset + size,
+		}); err != nil {
+			return nil, "", merrors.InternalServerError(s.id, "could not create page token: %v", err.Error())
+		}
+	}
+	return
+}
@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageToken(t *testing.T) {
+	fp := fingerprint("account", "preferred_name eq 'einstein'")
+	token, err := s.encodePageToken(&pageToken{
+		After:       []string{"4c510ada-c86b-4815-8820-42cdf82c3d51"},
+		Fingerprint: fp,
+	})
+	assert.NoError(t, err)
+
+	decoded, err := s.decodePageToken(token, fp)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"4c510ada-c86b-4815-8820-42cdf82c3d51"}, decoded.After)
+
+	// tokens can only be used for the request they were issued for
+	_, err = s.decodePageToken(token, fingerprint("account", ""))
+	assert.Error(t, err)
+
+	// tampered tokens are rejected
+	_, err = s.decodePageToken("e30"+token[3:], fp)
+	assert.Error(t, err)
+	_, err = s.decodePageToken("garbage", fp)
+	assert.Error(t, err)
+}
+
+func TestPageSize(t *testing.T) {
+	var scenarios = []struct {
+		name      string
+		requested int32
+		expected  int
+		err       bool
+	}{
+		{"zero returns the maximum", 0, defaultMaxPageSize, false},
+		{"sizes within the limit are kept", 50, 50, false},
+		{"sizes above the limit are capped", defaultMaxPageSize + 1, defaultMaxPageSize, false},
+		{"negative sizes are rejected", -1, 0, true},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			size, err := s.pageSize(scenario.requested)
+			if scenario.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, scenario.expected, size)
+		})
+	}
+}
+
+func TestQueryLimits(t *testing.T) {
+	cfg := config.New()
+	cfg.Search.MaxQueryDepth = 2
+	cfg.Search.MaxQueryClauses = 3
+	cfg.Search.MaxResultWindow = 100
+	svc := Service{Config: cfg}
+
+	leaf := func() query.Query { return bleve.NewTermQuery("einstein") }
+
+	assert.NoError(t, svc.checkQueryLimits(bleve.NewConjunctionQuery(leaf(), bleve.NewDisjunctionQuery(leaf(), leaf()))))
+	assert.Error(t, svc.checkQueryLimits(bleve.NewConjunctionQuery(bleve.NewDisjunctionQuery(bleve.NewConjunctionQuery(leaf())))), "too deep")
+	assert.Error(t, svc.checkQueryLimits(bleve.NewDisjunctionQuery(leaf(), leaf(), leaf(), leaf())), "too many clauses")
+	assert.Error(t, svc.checkQueryLimits(bleve.NewDocIDQuery([]string{"1", "2", "3", "4"})), "too many ids")
+
+	assert.NoError(t, svc.checkResultWindow(90, 10))
+	assert.Error(t, svc.checkResultWindow(91, 10))
+
+	cfg.Search.MaxPageSize = 101
+	_, err := New(Config(cfg))
+	assert.Error(t, err, "the first page must fit into the result window")
+}
+
+func TestListPages(t *testing.T) {
+	for _, storeRecords := range []bool{false, true} {
+		svc, teardown := newTestService(t)
+		svc.Config.Search.StoreRecords = storeRecords
+
+		// pairs of accounts and groups with equal names, only the id orders them. They are written in reverse order,
+		// so the order of the index does not match the order of the ids.
+		for i := 9; i >= 0; i-- {
+			addTestAccount(t, svc, &proto.Account{Id: fmt.Sprintf("account-%02d", i), DisplayName: []string{"Marie Curie", "Albert Einstein"}[i%2], AccountEnabled: true}, "")
+		}
+		for i := 3; i >= 0; i-- {
+			addTestGroup(t, svc, &proto.Group{Id: fmt.Sprintf("physicists-%d", i), DisplayName: "Physicists"})
+		}
+
+		listAccounts := func(pageSize int32) []string {
+			ids := []string{}
+			token := ""
+			for pages := 0; pages < 100; pages++ {
+				out := &proto.ListAccountsResponse{}
+				if !assert.NoError(t, svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: pageSize, OrderBy: "display_name", PageToken: token}, out)) {
+					break
+				}
+				assert.NotEmpty(t, out.Accounts, "there are no empty pages")
+				for _, a := range out.Accounts {
+					ids = append(ids, a.Id)
+				}
+				if token = out.NextPageToken; token == "" {
+					break
+				}
+			}
+			return ids
+		}
+		expected := []string{
+			"account-01", "account-03", "account-05", "account-07", "account-09",
+			"account-00", "account-02", "account-04", "account-06", "account-08",
+		}
+		for _, pageSize := range []int32{1, 3, 5, 10, 11} {
+			assert.Equal(t, expected, listAccounts(pageSize), "page size %d, stored records: %v", pageSize, storeRecords)
+		}
+
+		all := &proto.ListGroupsResponse{}
+		assert.NoError(t, svc.ListGroups(context.Background(), &proto.ListGroupsRequest{PageSize: 100, OrderBy: "display_name desc"}, all))
+		expectedGroups := []string{}
+		for _, g := range all.Groups {
+			expectedGroups = append(expectedGroups, g.Id)
+		}
+		assert.Equal(t, []string{"physicists-0", "physicists-1", "physicists-2", "physicists-3"}, expectedGroups, "equal names are ordered by id")
+
+		groups := []string{}
+		token := ""
+		for pages := 0; pages < 100; pages++ {
+			out := &proto.ListGroupsResponse{}
+			if !assert.NoError(t, svc.ListGroups(context.Background(), &proto.ListGroupsRequest{PageSize: 3, OrderBy: "display_name desc", PageToken: token}, out)) {
+				break
+			}
+			for _, g := range out.Groups {
+				groups = append(groups, g.Id)
+			}
+			if token = out.NextPageToken; token == "" {
+				break
+			}
+		}
+		assert.Equal(t, expectedGroups, groups, "stored records: %v", storeRecords)
+
+		teardown()
+	}
+}
+
+func TestPageTokenFingerprint(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	ctx := context.Background()
+	for i := 0; i < 10; i++ {
+		addTestAccount(t, svc, &proto.Account{Id: fmt.Sprintf("account-%02d", i), DisplayName: fmt.Sprintf("User %d", i), AccountEnabled: true}, "")
+	}
+
+	first := &proto.ListAccountsResponse{}
+	assert.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{PageSize: 3, OrderBy: "display_name", Query: "account_enabled eq true"}, first))
+	token := first.NextPageToken
+	assert.NotEmpty(t, token)
+
+	next := &proto.ListAccountsResponse{}
+	assert.NoError(t, svc.ListAccounts(ctx, &proto.ListAccountsRequest{PageSize: 5, OrderBy: "display_name", Query: "account_enabled eq true", PageToken: token}, next), "the page size can change")
+	assert.Len(t, next.Accounts, 5)
+
+	var scenarios = []struct {
+		name    string
+		request *proto.ListAccountsRequest
+	}{
+		{"changed order", &proto.ListAccountsRequest{PageSize: 3, OrderBy: "display_name desc", Query: "account_enabled eq true", PageToken: token}},
+		{"other order", &proto.ListAccountsRequest{PageSize: 3, OrderBy: "mail", Query: "account_enabled eq true", PageToken: token}},
+		{"default order", &proto.ListAccountsRequest{PageSize: 3, Query: "account_enabled eq true", PageToken: token}},
+		{"changed query", &proto.ListAccountsRequest{PageSize: 3, OrderBy: "display_name", Query: "account_enabled eq false", PageToken: token}},
+		{"no query", &proto.ListAccountsRequest{PageSize: 3, OrderBy: "display_name", PageToken: token}},
+		{"added search", &proto.ListAccountsRequest{PageSize: 3, OrderBy: "display_name", Query: "account_enabled eq true", Search: "user", PageToken: token}},
+	}
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			assert.Error(t, svc.ListAccounts(ctx, scenario.request, &proto.ListAccountsResponse{}))
+		})
+	}
+
+	t.Run("other record type", func(t *testing.T) {
+		assert.Error(t, svc.ListGroups(ctx, &proto.ListGroupsRequest{PageSize: 3, OrderBy: "display_name", Query: "account_enabled eq true", PageToken: token}, &proto.ListGroupsResponse{}))
+	})
+}
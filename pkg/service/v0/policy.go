@@ -0,0 +1,52 @@
+package service
+
+import (
+	"fmt"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// newPasswordPolicy builds the password policy from the config and reads the breached passwords
+func newPasswordPolicy(cfg config.PasswordPolicy) (*password.Policy, error) {
+	p := &password.Policy{
+		MinLength:            cfg.MinLength,
+		MinCharacterClasses:  cfg.MinCharacterClasses,
+		MinEntropy:           cfg.MinEntropy,
+		DisallowPersonalData: cfg.DisallowPersonalData,
+	}
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+	if cfg.BreachedPasswordsFile != "" {
+		var err error
+		if p.Breached, err = password.ReadBreachedFile(cfg.BreachedPasswordsFile); err != nil {
+			return nil, fmt.Errorf("could not read breached passwords: %v", err)
+		}
+	}
+	return p, nil
+}
+
+// hasPolicy returns true if the password profile contains the given password policy
+func hasPolicy(pp *proto.PasswordProfile, policy string) bool {
+	for _, p := range pp.GetPasswordPolicies() {
+		if p == policy {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPasswordPolicy checks a new password of the account against the password policy, unless the
+// DisableStrongPassword policy is set for the account. The violated rules are listed in the error.
+func (s Service) checkPasswordPolicy(a *proto.Account, pwd string) error {
+	if s.policy == nil || hasPolicy(a.PasswordProfile, policyDisableStrongPassword) {
+		return nil
+	}
+	if violations := s.policy.Check(pwd, a.OnPremisesSamAccountName, a.PreferredName, a.Mail, a.DisplayName); len(violations) > 0 {
+		return merrors.BadRequest(s.id, "password does not meet the password policy: %s", violations.Error())
+	}
+	return nil
+}
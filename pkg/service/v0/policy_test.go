@@ -0,0 +1,60 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/password"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.policy = &password.Policy{MinLength: 8, MinCharacterClasses: 3, MinEntropy: 40, DisallowPersonalData: true}
+
+	a := einstein()
+	a.Mail = "albert@example.org"
+	a.DisplayName = "Albert Einstein"
+	addTestAccount(t, svc, a, "")
+
+	var scenarios = []struct {
+		name     string
+		password string
+		policies []string
+		rules    []string
+	}{
+		{"strong password", "Gravity-Waves-1915", nil, nil},
+		{"too short", "E=mc2!", nil, []string{password.RuleMinLength}},
+		{"single character class", "relativity", nil, []string{password.RuleCharacterClasses}},
+		{"sequences", "Abcdefgh12345678", nil, []string{password.RuleEntropy}},
+		{"username", "Einstein-1879!", nil, []string{password.RulePersonalData}},
+		{"local part of the mail", "Albert-1879!", nil, []string{password.RulePersonalData}},
+		{"weak password with disabled policy", "relativity", []string{policyDisableStrongPassword}, nil},
+	}
+
+	for _, scenario := range scenarios {
+		t.Run(scenario.name, func(t *testing.T) {
+			out := &proto.Account{}
+			err := svc.UpdateAccount(context.Background(), &proto.UpdateAccountRequest{
+				Account:    &proto.Account{Id: "einstein", PasswordProfile: &proto.PasswordProfile{Password: scenario.password, PasswordPolicies: scenario.policies}},
+				UpdateMask: &field_mask.FieldMask{Paths: []string{"PasswordProfile.Password", "PasswordProfile.PasswordPolicies"}},
+			}, out)
+			if len(scenario.rules) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			if assert.Error(t, err) {
+				merr := merrors.FromError(err)
+				assert.Equal(t, int32(http.StatusBadRequest), merr.GetCode())
+				for _, rule := range scenario.rules {
+					assert.Contains(t, merr.GetDetail(), rule+": ")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search/query"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// _defaultPrincipalsPageSize is used when a SearchPrincipalsRequest does not specify a page size
+const _defaultPrincipalsPageSize = 10
+
+// principalFields are loaded from the index to build the principals without reading the records from disk
+var principalFields = []string{"bleve_type", "display_name", "preferred_name", "on_premises_sam_account_name", "mail"}
+
+// hiddenGroupIDs returns the ids of all groups that should not show up in address lists. The groups are searched in
+// pages, members of hidden groups must never be found because a page was too small.
+func (s Service) hiddenGroupIDs() ([]string, error) {
+	tq := bleve.NewTermQuery("group")
+	tq.SetField("bleve_type")
+	hq := bleve.NewBoolFieldQuery(true)
+	hq.SetField("hide_from_address_lists")
+	q := bleve.NewConjunctionQuery(tq, hq)
+
+	ids := []string{}
+	for {
+		searchRequest := bleve.NewSearchRequestOptions(q, defaultMaxPageSize, len(ids), false)
+		searchResult, err := s.index.Search(searchRequest)
+		if err != nil {
+			return nil, err
+		}
+		for _, hit := range searchResult.Hits {
+			ids = append(ids, hit.ID)
+		}
+		if len(searchResult.Hits) == 0 || uint64(len(ids)) >= searchResult.Total {
+			return ids, nil
+		}
+	}
+}
+
+// principalsQuery matches all words of text as prefixes. Disabled accounts, hidden groups and members of hidden
+// groups are excluded.
+func principalsQuery(text string, hiddenGroupIDs []string) query.Query {
+	mq := bleve.NewMatchQuery(text)
+	mq.SetField("prefix")
+	// don't split the words of the query into n-grams
+	mq.Analyzer = "name"
+	mq.SetOperator(query.MatchQueryOperatorAnd)
+
+	aq := bleve.NewBooleanQuery()
+	atq := bleve.NewTermQuery("account")
+	atq.SetField("bleve_type")
+	eq := bleve.NewBoolFieldQuery(true)
+	eq.SetField("account_enabled")
+	aq.AddMust(atq, eq)
+	for _, id := range hiddenGroupIDs {
+		mtq := bleve.NewTermQuery(id)
+		mtq.SetField("memberOf.id")
+		aq.AddMustNot(mtq)
+	}
+
+	gq := bleve.NewBooleanQuery()
+	gtq := bleve.NewTermQuery("group")
+	gtq.SetField("bleve_type")
+	hq := bleve.NewBoolFieldQuery(true)
+	hq.SetField("hide_from_address_lists")
+	gq.AddMust(gtq)
+	gq.AddMustNot(hq)
+
+	return bleve.NewConjunctionQuery(mq, bleve.NewDisjunctionQuery(aq, gq))
+}
+
+// SearchPrincipals implements the AccountsServiceHandler interface. There is deliberately no permission check, every
+// user needs to find the accounts and groups to share with. Only the names and the mail of enabled accounts and of
+// groups that are not hidden from address lists are returned, members of hidden groups are left out as well.
+func (s Service) SearchPrincipals(ctx context.Context, in *proto.SearchPrincipalsRequest, out *proto.SearchPrincipalsResponse) (err error) {
+	if strings.TrimSpace(in.Query) == "" {
+		return merrors.BadRequest(s.id, "query must not be empty")
+	}
+
+	var size int
+	if in.PageSize == 0 {
+		size = _defaultPrincipalsPageSize
+	} else if size, err = s.pageSize(in.PageSize); err != nil {
+		return err
+	}
+
+	hidden, err := s.hiddenGroupIDs()
+	if err != nil {
+		s.log.Error().Err(err).Msg("could not search hidden groups")
+		return merrors.InternalServerError(s.id, "could not search hidden groups: %v", err.Error())
+	}
+
+	searchRequest := bleve.NewSearchRequest(principalsQuery(in.Query, hidden))
+	searchRequest.Size = size
+	searchRequest.Fields = principalFields
+	searchResult, err := s.search(ctx, searchRequest)
+	if err != nil {
+		return err
+	}
+
+	out.Principals = make([]*proto.Principal, 0, len(searchResult.Hits))
+	for _, hit := range searchResult.Hits {
+		out.Principals = append(out.Principals, &proto.Principal{
+			Id:                       hit.ID,
+			Type:                     storedString(hit.Fields, "bleve_type"),
+			DisplayName:              storedString(hit.Fields, "display_name"),
+			PreferredName:            storedString(hit.Fields, "preferred_name"),
+			OnPremisesSamAccountName: storedString(hit.Fields, "on_premises_sam_account_name"),
+			Mail:                     storedString(hit.Fields, "mail"),
+			Score:                    hit.Score,
+		})
+	}
+	return nil
+}
+
+// storedString returns the first value of a stored field of a search hit
+func storedString(fields map[string]interface{}, name string) string {
+	switch v := fields[name].(type) {
+	case string:
+		return v
+	case []interface{}:
+		if len(v) > 0 {
+			if str, ok := v[0].(string); ok {
+				return str
+			}
+		}
+	}
+	return ""
+}
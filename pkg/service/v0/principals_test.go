@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchPrincipalsHidesMembersOfAllHiddenGroups(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	// more hidden groups than fit on a page
+	hidden := defaultMaxPageSize + 5
+	batch := svc.index.NewBatch()
+	for i := 0; i < hidden; i++ {
+		g := &proto.BleveGroup{Group: proto.Group{Id: fmt.Sprintf("hidden-%04d", i), HideFromAddressLists: true}, BleveType: "group"}
+		assert.NoError(t, batch.Index(g.Id, g))
+	}
+	assert.NoError(t, svc.index.Batch(batch))
+
+	ids, err := svc.hiddenGroupIDs()
+	assert.NoError(t, err)
+	assert.Len(t, ids, hidden)
+
+	for _, a := range []*proto.Account{
+		{Id: "einstein", DisplayName: "Albert Einstein", AccountEnabled: true, MemberOf: []*proto.Group{{Id: fmt.Sprintf("hidden-%04d", hidden-1)}}},
+		{Id: "marie", DisplayName: "Albert Marie", AccountEnabled: true},
+	} {
+		addTestAccount(t, svc, a, "")
+	}
+
+	out := &proto.SearchPrincipalsResponse{}
+	assert.NoError(t, svc.SearchPrincipals(context.Background(), &proto.SearchPrincipalsRequest{Query: "albert"}, out))
+	found := []string{}
+	for _, p := range out.Principals {
+		found = append(found, p.Id)
+	}
+	assert.Equal(t, []string{"marie"}, found, "members of the last hidden group are not found")
+}
+
+func TestHideSystemUsersGroup(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	assert.NoError(t, svc.hideSystemUsersGroup(), "a deleted group is not created again")
+	assert.Error(t, svc.loadGroup(systemUsersGroupID, &proto.Group{}))
+
+	// the group of an existing installation that was created without the flag
+	g := &proto.Group{Id: systemUsersGroupID, OnPremisesSamAccountName: "sysusers", DisplayName: "Technical users", Members: []*proto.Account{{Id: "820ba2a1-3f54-4538-80a4-2d73007e30bf"}}}
+	assert.NoError(t, svc.writeGroup(g))
+	assert.NoError(t, svc.hideSystemUsersGroup())
+
+	migrated := &proto.Group{}
+	assert.NoError(t, svc.loadGroup(systemUsersGroupID, migrated))
+	assert.True(t, migrated.HideFromAddressLists)
+	assert.Equal(t, "Technical users", migrated.DisplayName)
+	assert.Len(t, migrated.Members, 1)
+}
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"strings"
@@ -16,10 +17,15 @@ import (
 	"github.com/blevesearch/bleve/analysis/analyzer/keyword"
 	"github.com/blevesearch/bleve/analysis/analyzer/simple"
 	"github.com/blevesearch/bleve/analysis/analyzer/standard"
+	"github.com/blevesearch/bleve/analysis/token/edgengram"
 	"github.com/blevesearch/bleve/analysis/token/lowercase"
+	"github.com/blevesearch/bleve/analysis/tokenizer/single"
 	"github.com/blevesearch/bleve/analysis/tokenizer/unicode"
+	"github.com/blevesearch/bleve/mapping"
 	mclient "github.com/micro/go-micro/v2/client"
 	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/mfa"
+	"github.com/owncloud/ocis-accounts/pkg/password"
 	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
 	"github.com/owncloud/ocis-pkg/v2/log"
 	"github.com/owncloud/ocis-pkg/v2/roles"
@@ -56,6 +62,39 @@ func New(opts ...Option) (s *Service, err error) {
 		Config:      cfg,
 		RoleService: roleService,
 		RoleManager: roleManager,
+		sources:     newSourceTracker(),
+	}
+
+	if s.pageTokenKey, err = newPageTokenKey(cfg.Search.PageTokenSecret); err != nil {
+		return nil, err
+	}
+	// every first page would exceed the result window
+	if s.maxPageSize() > s.maxResultWindow() {
+		return nil, fmt.Errorf("the max page size %d exceeds the max result window %d", s.maxPageSize(), s.maxResultWindow())
+	}
+
+	if s.passwords, err = password.NewRegistry(cfg.Auth.PasswordHashAlgorithm, cfg.Auth.PasswordHashCost); err != nil {
+		return nil, err
+	}
+
+	if s.policy, err = newPasswordPolicy(cfg.PasswordPolicy); err != nil {
+		return nil, err
+	}
+
+	if s.trustedProxies, err = parseTrustedProxies(cfg.Auth.TrustedProxies); err != nil {
+		return nil, err
+	}
+	// sign-ins come in from glauth, konnectd or the proxy. Without trusted proxies their address would be locked
+	// instead of the client, which locks out every user
+	if cfg.Auth.SourceLockoutThreshold > 0 && len(s.trustedProxies) == 0 {
+		return nil, errors.New("the source lockout needs trusted proxies")
+	}
+
+	if s.secrets, err = newSecretsCipher(cfg); err != nil {
+		return nil, err
+	}
+	if s.secrets == nil {
+		logger.Warn().Msg("no MFA secret key or key file configured, TOTP cannot be enrolled")
 	}
 
 	// build an index
@@ -77,6 +116,9 @@ func New(opts ...Option) (s *Service, err error) {
 	if err = s.createDefaultGroups(groupsDir); err != nil {
 		return nil, err
 	}
+	if err = s.hideSystemUsersGroup(); err != nil {
+		return nil, err
+	}
 	if err = s.indexGroups(groupsDir); err != nil {
 		return nil, err
 	}
@@ -87,10 +129,28 @@ func New(opts ...Option) (s *Service, err error) {
 }
 
 func (s Service) buildIndex() (index bleve.Index, err error) {
-	indexMapping := bleve.NewIndexMapping()
+	var indexMapping *mapping.IndexMappingImpl
+	if indexMapping, err = buildIndexMapping(); err != nil {
+		return nil, err
+	}
+
+	indexDir := filepath.Join(s.Config.Server.AccountsDataPath, "index.bleve")
+	// for now recreate index on every start, which also takes care of migrating to a changed mapping
+	if err = os.RemoveAll(indexDir); err != nil {
+		return
+	}
+	if index, err = bleve.New(indexDir, indexMapping); err != nil {
+		return nil, err
+	}
+	return
+}
+
+// buildIndexMapping describes how accounts and groups are indexed
+func buildIndexMapping() (indexMapping *mapping.IndexMappingImpl, err error) {
+	indexMapping = bleve.NewIndexMapping()
 	// keep all symbols in terms to allow exact maching, eg. emails
 	indexMapping.DefaultAnalyzer = keyword.Name
-	// TODO don't bother to store fields as we will load the account from disk
+	// the records are read from disk unless they are stored in the source field, see Config.Search.StoreRecords
 
 	// Reusable mapping for text
 	standardTextFieldMapping := bleve.NewTextFieldMapping()
@@ -105,7 +165,7 @@ func (s Service) buildIndex() (index bleve.Index, err error) {
 	// Reusable mapping for keyword text
 	keywordFieldMapping := bleve.NewTextFieldMapping()
 	keywordFieldMapping.Analyzer = keyword.Name
-	keywordFieldMapping.Store = false
+	keywordFieldMapping.Store = true
 
 	// Reusable mapping for lowercase text
 	err = indexMapping.AddCustomAnalyzer("lowercase",
@@ -123,31 +183,245 @@ func (s Service) buildIndex() (index bleve.Index, err error) {
 	lowercaseTextFieldMapping.Analyzer = "lowercase"
 	lowercaseTextFieldMapping.Store = true
 
-	// accounts
-	accountMapping := bleve.NewDocumentMapping()
+	// Reusable mapping for human names, lowercase without diacritics so `muller` finds `Müller`
+	err = indexMapping.AddCustomAnalyzer("name",
+		map[string]interface{}{
+			"type":      custom.Name,
+			"tokenizer": unicode.Name,
+			"token_filters": []string{
+				lowercase.Name,
+				foldingFilterName,
+			},
+		})
+	if err != nil {
+		return
+	}
+	nameTextFieldMapping := bleve.NewTextFieldMapping()
+	nameTextFieldMapping.Analyzer = "name"
+	nameTextFieldMapping.Store = true
+
+	// Reusable mapping for the prefix search of principals, all fields are indexed as edge n-grams in a shared field
+	err = indexMapping.AddCustomTokenFilter("edge_ngram_1_20",
+		map[string]interface{}{
+			"type": edgengram.Name,
+			"back": false,
+			"min":  1.0,
+			"max":  20.0,
+		})
+	if err != nil {
+		return
+	}
+	err = indexMapping.AddCustomAnalyzer("prefix",
+		map[string]interface{}{
+			"type":      custom.Name,
+			"tokenizer": unicode.Name,
+			"token_filters": []string{
+				lowercase.Name,
+				foldingFilterName,
+				"edge_ngram_1_20",
+			},
+		})
+	if err != nil {
+		return
+	}
+	prefixFieldMapping := bleve.NewTextFieldMapping()
+	prefixFieldMapping.Name = "prefix"
+	prefixFieldMapping.Analyzer = "prefix"
+	prefixFieldMapping.Store = false
+	prefixFieldMapping.IncludeInAll = false
+
+	// Reusable mapping for sorting, keeps the whole value as a single lowercase term without diacritics
+	err = indexMapping.AddCustomAnalyzer("sort",
+		map[string]interface{}{
+			"type":      custom.Name,
+			"tokenizer": single.Name,
+			"token_filters": []string{
+				lowercase.Name,
+				foldingFilterName,
+			},
+		})
+	if err != nil {
+		return
+	}
+	sortFieldMapping := func(name string) *mapping.FieldMapping {
+		fm := bleve.NewTextFieldMapping()
+		fm.Name = name
+		fm.Analyzer = "sort"
+		fm.Store = false
+		fm.IncludeInAll = false
+		return fm
+	}
+
+	// Reusable mappings for typed values
+	booleanFieldMapping := bleve.NewBooleanFieldMapping()
+	booleanFieldMapping.Store = false
+
+	numericFieldMapping := bleve.NewNumericFieldMapping()
+	numericFieldMapping.Store = false
+
+	// The type is stored, so the principals can be built from the search hits
+	typeFieldMapping := bleve.NewTextFieldMapping()
+	typeFieldMapping.Analyzer = keyword.Name
+	typeFieldMapping.Store = true
+	typeFieldMapping.IncludeInAll = false
+
+	// The json of a record is only stored, never indexed
+	sourceFieldMapping := bleve.NewTextFieldMapping()
+	sourceFieldMapping.Index = false
+	sourceFieldMapping.Store = true
+	sourceFieldMapping.IncludeInAll = false
+	sourceFieldMapping.DocValues = false
+
+	// Timestamps are indexed as the number of seconds since the epoch, eg. `created_date_time.seconds`. They are not
+	// mapped as date times, the protobuf timestamps are structs and not times. OData filters compare date times with
+	// the seconds.
+	timestampMapping := func() *mapping.DocumentMapping {
+		m := bleve.NewDocumentStaticMapping()
+		m.AddFieldMappingsAt("seconds", numericFieldMapping)
+		return m
+	}
+
+	// References to other records only index the id, eg. `memberOf.id`
+	referenceMapping := func() *mapping.DocumentMapping {
+		m := bleve.NewDocumentStaticMapping()
+		m.AddFieldMappingsAt("id", keywordFieldMapping)
+		return m
+	}
+
+	provisioningErrorMapping := bleve.NewDocumentStaticMapping()
+	provisioningErrorMapping.AddFieldMappingsAt("category", keywordFieldMapping)
+	provisioningErrorMapping.AddFieldMappingsAt("property_causing_error", keywordFieldMapping)
+	provisioningErrorMapping.AddSubDocumentMapping("occurred_date_time", timestampMapping())
+
+	// accounts, only the mapped fields are indexed
+	accountMapping := bleve.NewDocumentStaticMapping()
 	indexMapping.AddDocumentMapping("account", accountMapping)
 
+	accountMapping.AddFieldMappingsAt("bleve_type", typeFieldMapping)
+	accountMapping.AddFieldMappingsAt(_sourceField, sourceFieldMapping)
+
+	// Names
+	accountMapping.AddFieldMappingsAt("display_name", nameTextFieldMapping, sortFieldMapping("display_name_sort"), prefixFieldMapping)
+
 	// Text
-	accountMapping.AddFieldMappingsAt("display_name", standardTextFieldMapping)
 	accountMapping.AddFieldMappingsAt("description", standardTextFieldMapping)
 
 	// Lowercase
-	accountMapping.AddFieldMappingsAt("on_premises_sam_account_name", lowercaseTextFieldMapping)
-	accountMapping.AddFieldMappingsAt("preferred_name", lowercaseTextFieldMapping)
+	accountMapping.AddFieldMappingsAt("on_premises_sam_account_name", lowercaseTextFieldMapping, sortFieldMapping("on_premises_sam_account_name_sort"), prefixFieldMapping)
+	accountMapping.AddFieldMappingsAt("preferred_name", lowercaseTextFieldMapping, sortFieldMapping("preferred_name_sort"), prefixFieldMapping)
 
 	// Keywords
-	accountMapping.AddFieldMappingsAt("mail", keywordFieldMapping)
+	accountMapping.AddFieldMappingsAt("mail", keywordFieldMapping, sortFieldMapping("mail_sort"), prefixFieldMapping)
+	for _, field := range []string{
+		"id",
+		"creation_type",
+		"external_user_state",
+		"on_premises_immutable_id",
+		"on_premises_security_identifier",
+		"on_premises_distinguished_name",
+		"on_premises_domain_name",
+		"on_premises_user_principal_name",
+	} {
+		accountMapping.AddFieldMappingsAt(field, keywordFieldMapping)
+	}
+
+	// Booleans
+	for _, field := range []string{"account_enabled", "is_resource_account", "on_premises_sync_enabled"} {
+		accountMapping.AddFieldMappingsAt(field, booleanFieldMapping)
+	}
+
+	// Numbers
+	accountMapping.AddFieldMappingsAt("uid_number", numericFieldMapping)
+	accountMapping.AddFieldMappingsAt("gid_number", numericFieldMapping)
+	accountMapping.AddFieldMappingsAt("failed_sign_in_attempts", numericFieldMapping)
 
-	// groups
-	groupMapping := bleve.NewDocumentMapping()
+	// Timestamps
+	for _, field := range []string{
+		"created_date_time",
+		"deleted_date_time",
+		"on_premises_last_sync_date_time",
+		"external_user_state_change_date_time",
+		"refresh_tokens_valid_from_date_time",
+		"sign_in_sessions_valid_from_date_time",
+		"locked_until_date_time",
+	} {
+		accountMapping.AddSubDocumentMapping(field, timestampMapping())
+	}
+
+	// Nested records
+	identitiesMapping := bleve.NewDocumentStaticMapping()
+	identitiesMapping.AddFieldMappingsAt("sign_in_type", keywordFieldMapping)
+	identitiesMapping.AddFieldMappingsAt("issuer", keywordFieldMapping)
+	identitiesMapping.AddFieldMappingsAt("issuer_assigned_id", keywordFieldMapping)
+	accountMapping.AddSubDocumentMapping("identities", identitiesMapping)
+
+	// never index the password itself
+	passwordProfileMapping := bleve.NewDocumentStaticMapping()
+	passwordProfileMapping.AddSubDocumentMapping("last_password_change_date_time", timestampMapping())
+	passwordProfileMapping.AddFieldMappingsAt("password_policies", keywordFieldMapping)
+	passwordProfileMapping.AddFieldMappingsAt("force_change_password_next_sign_in", booleanFieldMapping)
+	passwordProfileMapping.AddFieldMappingsAt("force_change_password_next_sign_in_with_mfa", booleanFieldMapping)
+	accountMapping.AddSubDocumentMapping("password_profile", passwordProfileMapping)
+
+	// never index the multi-factor secrets
+	multiFactorProfileMapping := bleve.NewDocumentStaticMapping()
+	multiFactorProfileMapping.AddFieldMappingsAt("totp_enabled", booleanFieldMapping)
+	multiFactorProfileMapping.AddSubDocumentMapping("totp_enabled_date_time", timestampMapping())
+	multiFactorProfileMapping.AddFieldMappingsAt("remaining_recovery_codes", numericFieldMapping)
+	accountMapping.AddSubDocumentMapping("multi_factor_profile", multiFactorProfileMapping)
+
+	accountMapping.AddSubDocumentMapping("memberOf", referenceMapping())
+	accountMapping.AddSubDocumentMapping("on_premises_provisioning_errors", provisioningErrorMapping)
+
+	// groups, only the mapped fields are indexed
+	groupMapping := bleve.NewDocumentStaticMapping()
 	indexMapping.AddDocumentMapping("group", groupMapping)
 
+	groupMapping.AddFieldMappingsAt("bleve_type", typeFieldMapping)
+	groupMapping.AddFieldMappingsAt(_sourceField, sourceFieldMapping)
+
+	// Names
+	groupMapping.AddFieldMappingsAt("display_name", nameTextFieldMapping, sortFieldMapping("display_name_sort"), prefixFieldMapping)
+
 	// Text
-	groupMapping.AddFieldMappingsAt("display_name", standardTextFieldMapping)
 	groupMapping.AddFieldMappingsAt("description", standardTextFieldMapping)
 
 	// Lowercase
-	groupMapping.AddFieldMappingsAt("on_premises_sam_account_name", lowercaseTextFieldMapping)
+	groupMapping.AddFieldMappingsAt("on_premises_sam_account_name", lowercaseTextFieldMapping, sortFieldMapping("on_premises_sam_account_name_sort"), prefixFieldMapping)
+
+	// Keywords
+	for _, field := range []string{
+		"id",
+		"visibility",
+		"on_premises_immutable_id",
+		"on_premises_security_identifier",
+		"on_premises_distinguished_name",
+		"on_premises_domain_name",
+		"on_premises_net_bios_name",
+		// a string in the group, unlike the timestamp of accounts
+		"on_premises_last_sync_date_time",
+	} {
+		groupMapping.AddFieldMappingsAt(field, keywordFieldMapping)
+	}
+
+	// Booleans
+	groupMapping.AddFieldMappingsAt("hide_from_address_lists", booleanFieldMapping)
+	groupMapping.AddFieldMappingsAt("on_premises_sync_enabled", booleanFieldMapping)
+
+	// Numbers
+	groupMapping.AddFieldMappingsAt("gid_number", numericFieldMapping)
+
+	// Timestamps
+	for _, field := range []string{"created_date_time", "deleted_date_time", "expiration_date_time"} {
+		groupMapping.AddSubDocumentMapping(field, timestampMapping())
+	}
+
+	// Nested records
+	groupMapping.AddSubDocumentMapping("members", referenceMapping())
+	groupMapping.AddSubDocumentMapping("owners", referenceMapping())
+	groupMapping.AddSubDocumentMapping("member_groups", referenceMapping())
+	groupMapping.AddSubDocumentMapping("memberOf", referenceMapping())
+	groupMapping.AddSubDocumentMapping("on_premises_provisioning_errors", provisioningErrorMapping)
 
 	// Tell blevesearch how to determine the type of the structs that are indexed.
 	// The referenced field needs to match the struct field exactly and it must be public.
@@ -156,14 +430,6 @@ func (s Service) buildIndex() (index bleve.Index, err error) {
 	// documents in the index by querying for that property.
 	indexMapping.TypeField = "BleveType"
 
-	indexDir := filepath.Join(s.Config.Server.AccountsDataPath, "index.bleve")
-	// for now recreate index on every start
-	if err = os.RemoveAll(indexDir); err != nil {
-		return
-	}
-	if index, err = bleve.New(indexDir, indexMapping); err != nil {
-		return nil, err
-	}
 	return
 }
 
@@ -322,6 +588,9 @@ func (s Service) createDefaultAccounts(accountsDir string) (err error) {
 	return nil
 }
 
+// systemUsersGroupID is the id of the default group of the technical users, eg. konnectd and reva
+const systemUsersGroupID = "34f38767-c937-4eb6-b847-1c175829a2a0"
+
 func (s Service) createDefaultGroups(groupsDir string) (err error) {
 	// check if groups exist
 	var fi os.FileInfo
@@ -333,7 +602,7 @@ func (s Service) createDefaultGroups(groupsDir string) (err error) {
 			}
 			// create default accounts
 			groups := []proto.Group{
-				{Id: "34f38767-c937-4eb6-b847-1c175829a2a0", GidNumber: 15000, OnPremisesSamAccountName: "sysusers", DisplayName: "Technical users", Description: "A group for technical users. They should not show up in sharing dialogs.", Members: []*proto.Account{
+				{Id: systemUsersGroupID, GidNumber: 15000, OnPremisesSamAccountName: "sysusers", DisplayName: "Technical users", Description: "A group for technical users. They should not show up in sharing dialogs.", HideFromAddressLists: true, Members: []*proto.Account{
 					{Id: "820ba2a1-3f54-4538-80a4-2d73007e30bf"}, // konnectd
 					{Id: "bc596f3c-c955-4328-80a0-60d018b4ad57"}, // reva
 				}},
@@ -385,6 +654,26 @@ func (s Service) createDefaultGroups(groupsDir string) (err error) {
 	return nil
 }
 
+// hideSystemUsersGroup hides the default group of the technical users from address lists. The flag was only set when
+// the default groups were created, so it is migrated for existing installations. A deleted group is not created again.
+func (s Service) hideSystemUsersGroup() (err error) {
+	g := &proto.Group{}
+	if err = s.loadGroup(systemUsersGroupID, g); err != nil {
+		s.log.Debug().Err(err).Str("id", systemUsersGroupID).Msg("could not load system users group")
+		return nil
+	}
+	if g.HideFromAddressLists {
+		return nil
+	}
+	g.HideFromAddressLists = true
+	if err = s.writeGroup(g); err != nil {
+		s.log.Error().Err(err).Str("id", g.Id).Msg("could not hide system users group")
+		return
+	}
+	s.log.Info().Str("id", g.Id).Msg("hid system users group from address lists")
+	return nil
+}
+
 func assignRoleToUser(accountID, roleID string, rs settings.RoleService, logger log.Logger) (ok bool) {
 	_, err := rs.AssignRoleToUser(context.Background(), &settings.AssignRoleToUserRequest{
 		AccountUuid: accountID,
@@ -405,6 +694,24 @@ type Service struct {
 	index       bleve.Index
 	RoleService settings.RoleService
 	RoleManager *roles.Manager
+
+	// pageTokenKey is used to sign page tokens
+	pageTokenKey []byte
+
+	// passwords hashes new passwords with the configured algorithm
+	passwords *password.Registry
+
+	// policy is checked for new passwords
+	policy *password.Policy
+
+	// secrets encrypts the TOTP secrets of accounts
+	secrets *mfa.Cipher
+
+	// sources counts the failed sign-in attempts per client address
+	sources *sourceTracker
+
+	// trustedProxies may forward the address of the client in X-Forwarded-For or X-Real-Ip
+	trustedProxies []*net.IPNet
 }
 
 func cleanupID(id string) (string, error) {
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	olog "github.com/owncloud/ocis-pkg/v2/log"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// newTestService creates a service with an empty data path and index, the caller has to call teardown
+func newTestService(tb testing.TB) (svc Service, teardown func()) {
+	tb.Helper()
+	dir, err := ioutil.TempDir("", "ocis-accounts-test")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	teardown = func() { os.RemoveAll(dir) }
+
+	cfg := config.New()
+	cfg.Server.AccountsDataPath = dir
+	svc = Service{
+		id:      "com.owncloud.api.accounts",
+		log:     olog.NewLogger(olog.Level("error")),
+		Config:  cfg,
+		sources: newSourceTracker(),
+	}
+	if svc.pageTokenKey, err = newPageTokenKey(""); err != nil {
+		teardown()
+		tb.Fatal(err)
+	}
+	if svc.index, err = svc.buildIndex(); err != nil {
+		teardown()
+		tb.Fatal(err)
+	}
+	for _, d := range []string{"accounts", "groups"} {
+		if err = os.MkdirAll(filepath.Join(dir, d), 0700); err != nil {
+			teardown()
+			tb.Fatal(err)
+		}
+	}
+	return svc, teardown
+}
+
+// einstein returns the enabled account most tests sign in with, add it with the password relativity
+func einstein() *proto.Account {
+	return &proto.Account{Id: "einstein", AccountEnabled: true, PreferredName: "einstein", OnPremisesSamAccountName: "einstein"}
+}
+
+// addTestAccount writes and indexes the account. The password is hashed with the minimum bcrypt cost to keep the
+// tests fast, an empty password keeps the password profile of the account.
+func addTestAccount(tb testing.TB, svc Service, a *proto.Account, password string) {
+	tb.Helper()
+	if password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.MinCost)
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if a.PasswordProfile == nil {
+			a.PasswordProfile = &proto.PasswordProfile{}
+		}
+		a.PasswordProfile.Password = string(hash)
+	}
+	if err := svc.writeAccount(a); err != nil {
+		tb.Fatal(err)
+	}
+	if err := svc.indexAccount(a.Id); err != nil {
+		tb.Fatal(err)
+	}
+}
+
+// addTestGroup writes and indexes the group
+func addTestGroup(tb testing.TB, svc Service, g *proto.Group) {
+	tb.Helper()
+	if err := svc.writeGroup(g); err != nil {
+		tb.Fatal(err)
+	}
+	if err := svc.indexGroup(g.Id); err != nil {
+		tb.Fatal(err)
+	}
+}
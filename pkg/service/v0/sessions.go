@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// revokeSessions invalidates all sessions and refresh tokens of the account issued before now. It is called when
+// the password changes or the account is disabled, so stolen sessions can't be used any longer. The time is truncated
+// to whole seconds like the issued at claim of tokens, so signing in right after the revocation works.
+func revokeSessions(a *proto.Account, now time.Time) {
+	ts := timestamppb.New(now.Truncate(time.Second))
+	a.SignInSessionsValidFromDateTime = ts
+	a.RefreshTokensValidFromDateTime = ts
+}
+
+// sessionValid returns true if a session or refresh token of the given type issued at the given time is still valid
+// for the account. Tokens issued in the same second as the revocation are valid.
+func sessionValid(a *proto.Account, sessionType proto.SessionType, issued time.Time) bool {
+	if !a.AccountEnabled {
+		return false
+	}
+	validFrom := a.SignInSessionsValidFromDateTime
+	if sessionType == proto.SessionType_REFRESH_TOKEN {
+		validFrom = a.RefreshTokensValidFromDateTime
+	}
+	return validFrom == nil || !issued.Before(validFrom.AsTime())
+}
+
+// RevokeSessions implements the AccountsServiceHandler interface
+func (s Service) RevokeSessions(ctx context.Context, in *proto.RevokeSessionsRequest, out *proto.Account) (err error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "RevokeSessions", out); err != nil {
+		return
+	}
+	revokeSessions(out, time.Now())
+	if err = s.persistAccount(out); err != nil {
+		return
+	}
+	s.log.Info().Str("id", out.Id).Msg("revoked sessions")
+
+	// remove passwords
+	removeSecrets(out)
+	return nil
+}
+
+// ValidateSession implements the AccountsServiceHandler interface
+func (s Service) ValidateSession(ctx context.Context, in *proto.ValidateSessionRequest, out *proto.ValidateSessionResponse) (err error) {
+	if in.AccountId == "" {
+		return merrors.BadRequest(s.id, "account id missing")
+	}
+	if in.IssuedDateTime == nil {
+		return merrors.BadRequest(s.id, "issued date time missing")
+	}
+	var id string
+	if id, err = cleanupID(in.AccountId); err != nil {
+		return merrors.InternalServerError(s.id, "could not clean up account id: %v", err.Error())
+	}
+
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if err = s.loadAccount(id, a); err != nil {
+		// sessions of deleted accounts are invalid
+		s.log.Debug().Err(err).Str("id", id).Msg("could not load account to validate session")
+		out.Valid = false
+		return nil
+	}
+	out.Valid = sessionValid(a, in.Type, in.IssuedDateTime.AsTime())
+	return nil
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/protobuf/field_mask"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSessions(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	ctx := context.Background()
+	validate := func(id string, sessionType proto.SessionType, issued time.Time) bool {
+		out := &proto.ValidateSessionResponse{}
+		assert.NoError(t, svc.ValidateSession(ctx, &proto.ValidateSessionRequest{AccountId: id, IssuedDateTime: timestamppb.New(issued), Type: sessionType}, out))
+		return out.Valid
+	}
+
+	issued := time.Now().Add(-time.Minute)
+	assert.True(t, validate("einstein", proto.SessionType_SIGN_IN_SESSION, issued), "sessions are valid until they are revoked")
+	assert.False(t, validate("bohr", proto.SessionType_SIGN_IN_SESSION, issued), "sessions of unknown accounts are invalid")
+	assert.Error(t, svc.ValidateSession(ctx, &proto.ValidateSessionRequest{AccountId: "einstein"}, &proto.ValidateSessionResponse{}))
+
+	revoked := &proto.Account{}
+	assert.NoError(t, svc.RevokeSessions(ctx, &proto.RevokeSessionsRequest{AccountId: "einstein"}, revoked))
+	assert.NotNil(t, revoked.SignInSessionsValidFromDateTime)
+	assert.Equal(t, revoked.SignInSessionsValidFromDateTime.AsTime(), revoked.RefreshTokensValidFromDateTime.AsTime())
+	assert.Empty(t, revoked.PasswordProfile.Password)
+	assert.False(t, validate("einstein", proto.SessionType_SIGN_IN_SESSION, issued))
+	assert.False(t, validate("einstein", proto.SessionType_REFRESH_TOKEN, issued))
+	assert.True(t, validate("einstein", proto.SessionType_REFRESH_TOKEN, revoked.RefreshTokensValidFromDateTime.AsTime()))
+
+	// changing the password revokes the sessions, tokens only have a resolution of seconds
+	issued = time.Now().Truncate(time.Second).Add(-time.Second)
+	changed := &proto.AuthenticateAccountResponse{}
+	assert.NoError(t, svc.ChangePassword(ctx, &proto.ChangePasswordRequest{Login: "einstein", CurrentPassword: "relativity", NewPassword: "photoelectric"}, changed))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, changed.Failure)
+	assert.False(t, validate("einstein", proto.SessionType_SIGN_IN_SESSION, issued))
+	assert.True(t, validate("einstein", proto.SessionType_SIGN_IN_SESSION, time.Now().Truncate(time.Second)), "signing in right after the change works")
+
+	// so does disabling the account, sessions of disabled accounts are invalid anyway
+	issued = time.Now().Truncate(time.Second)
+	assert.NoError(t, svc.UpdateAccount(ctx, &proto.UpdateAccountRequest{
+		Account:    &proto.Account{Id: "einstein", AccountEnabled: false},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"AccountEnabled"}},
+	}, &proto.Account{}))
+	disabled := &proto.Account{}
+	assert.NoError(t, svc.loadAccount("einstein", disabled))
+	assert.False(t, disabled.SignInSessionsValidFromDateTime.AsTime().Before(issued))
+	assert.False(t, validate("einstein", proto.SessionType_SIGN_IN_SESSION, time.Now()))
+}
+
+func TestRevokeSessionsInWholeSeconds(t *testing.T) {
+	now := time.Date(2020, 9, 13, 12, 26, 40, 700000000, time.UTC)
+	a := &proto.Account{AccountEnabled: true}
+	revokeSessions(a, now)
+	assert.Equal(t, now.Truncate(time.Second), a.SignInSessionsValidFromDateTime.AsTime())
+
+	// the issued at claim of a token has no fractions of a second
+	assert.True(t, sessionValid(a, proto.SessionType_SIGN_IN_SESSION, now.Truncate(time.Second)), "tokens issued in the same second are valid")
+	assert.True(t, sessionValid(a, proto.SessionType_REFRESH_TOKEN, now.Add(time.Second).Truncate(time.Second)))
+	assert.False(t, sessionValid(a, proto.SessionType_SIGN_IN_SESSION, now.Truncate(time.Second).Add(-time.Second)))
+}
@@ -0,0 +1,157 @@
+package service
+
+import (
+	"encoding/json"
+
+	"github.com/blevesearch/bleve"
+	"github.com/blevesearch/bleve/search"
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+)
+
+// _sourceField holds the json of a record in the index when records are stored, see Config.Search.StoreRecords
+const _sourceField = "source"
+
+// accountSource returns the json of an account without the password hashes, multi-factor secrets and app password
+// hashes
+func accountSource(a *proto.Account) (string, error) {
+	c := *a
+	if c.PasswordProfile != nil {
+		pp := *c.PasswordProfile
+		c.PasswordProfile = &pp
+	}
+	if c.MultiFactorProfile != nil {
+		mp := *c.MultiFactorProfile
+		c.MultiFactorProfile = &mp
+	}
+	if c.AppPasswords != nil {
+		apps := make([]*proto.AppPassword, 0, len(c.AppPasswords))
+		for _, app := range c.AppPasswords {
+			apps = append(apps, withoutHash(app))
+		}
+		c.AppPasswords = apps
+	}
+	removeSecrets(&c)
+	b, err := json.Marshal(&c)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// groupSource returns the json of a group
+func groupSource(g *proto.Group) (string, error) {
+	b, err := json.Marshal(g)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// sourceFields returns the stored fields to load with search hits
+func (s Service) sourceFields() []string {
+	if s.Config.Search.StoreRecords {
+		return []string{_sourceField}
+	}
+	return nil
+}
+
+// accountFromHit reads the account of a search hit from the stored source, falling back to the file on disk. Accounts
+// read from the index have no password hash.
+func (s Service) accountFromHit(hit *search.DocumentMatch, a *proto.Account) error {
+	if src := storedString(hit.Fields, _sourceField); src != "" {
+		if err := json.Unmarshal([]byte(src), a); err != nil {
+			return merrors.InternalServerError(s.id, "could not unmarshal account: %v", err.Error())
+		}
+		return nil
+	}
+	return s.loadAccount(hit.ID, a)
+}
+
+// groupFromHit reads the group of a search hit from the stored source, falling back to the file on disk
+func (s Service) groupFromHit(hit *search.DocumentMatch, g *proto.Group) error {
+	if src := storedString(hit.Fields, _sourceField); src != "" {
+		if err := json.Unmarshal([]byte(src), g); err != nil {
+			return merrors.InternalServerError(s.id, "could not unmarshal group: %v", err.Error())
+		}
+		return nil
+	}
+	return s.loadGroup(hit.ID, g)
+}
+
+// storedSources fetches the stored json of the records with the given ids with a single search. Records without a
+// stored source are missing in the result.
+func (s Service) storedSources(ids []string) map[string]string {
+	sources := map[string]string{}
+	if !s.Config.Search.StoreRecords || len(ids) == 0 {
+		return sources
+	}
+	searchRequest := bleve.NewSearchRequest(bleve.NewDocIDQuery(ids))
+	searchRequest.Size = len(ids)
+	searchRequest.Fields = []string{_sourceField}
+	searchResult, err := s.index.Search(searchRequest)
+	if err != nil {
+		// the records are read from disk instead
+		s.log.Error().Err(err).Msg("could not search stored records")
+		return sources
+	}
+	for _, hit := range searchResult.Hits {
+		if src := storedString(hit.Fields, _sourceField); src != "" {
+			sources[hit.ID] = src
+		}
+	}
+	return sources
+}
+
+// loadGroups loads every group only once, from the index if possible. Groups that can't be loaded are missing in the
+// result.
+func (s Service) loadGroups(ids []string) map[string]*proto.Group {
+	sources := s.storedSources(ids)
+	groups := make(map[string]*proto.Group, len(ids))
+	for _, id := range ids {
+		if _, ok := groups[id]; ok {
+			continue
+		}
+		g := &proto.Group{}
+		var err error
+		if src, ok := sources[id]; ok {
+			err = json.Unmarshal([]byte(src), g)
+		} else {
+			err = s.loadGroup(id, g)
+		}
+		if err != nil {
+			// log errors but continue execution for now
+			s.log.Error().Err(err).Str("id", id).Msg("could not load group")
+			continue
+		}
+		groups[id] = g
+	}
+	return groups
+}
+
+// loadAccounts loads every account only once, from the index if possible. Accounts that can't be loaded are missing
+// in the result. The accounts are returned to clients as members of groups, so their secrets are removed.
+func (s Service) loadAccounts(ids []string) map[string]*proto.Account {
+	sources := s.storedSources(ids)
+	accounts := make(map[string]*proto.Account, len(ids))
+	for _, id := range ids {
+		if _, ok := accounts[id]; ok {
+			continue
+		}
+		a := &proto.Account{}
+		var err error
+		if src, ok := sources[id]; ok {
+			err = json.Unmarshal([]byte(src), a)
+		} else {
+			err = s.loadAccount(id, a)
+		}
+		if err != nil {
+			// log errors but continue execution for now
+			s.log.Error().Err(err).Str("id", id).Msg("could not load account")
+			continue
+		}
+		removeSecrets(a)
+		accounts[id] = a
+	}
+	return accounts
+}
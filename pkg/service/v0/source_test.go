@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	_benchmarkAccounts = 50000
+	_benchmarkGroups   = 20
+)
+
+// newBenchmarkService creates a service with the given number of accounts, every account is a member of two groups
+func newBenchmarkService(tb testing.TB, storeRecords bool, accounts int) (svc Service, teardown func()) {
+	svc, teardown = newTestService(tb)
+	svc.Config.Search.StoreRecords = storeRecords
+
+	var err error
+	for i := 0; i < _benchmarkGroups; i++ {
+		g := &proto.Group{Id: fmt.Sprintf("group-%d", i), DisplayName: fmt.Sprintf("Group %d", i)}
+		if err = svc.writeGroup(g); err != nil {
+			tb.Fatal(err)
+		}
+		if err = svc.indexGroup(g.Id); err != nil {
+			tb.Fatal(err)
+		}
+	}
+
+	batch := svc.index.NewBatch()
+	for i := 0; i < accounts; i++ {
+		a := proto.Account{
+			Id:                       fmt.Sprintf("account-%06d", i),
+			AccountEnabled:           true,
+			DisplayName:              fmt.Sprintf("User %d", i),
+			PreferredName:            fmt.Sprintf("user%d", i),
+			OnPremisesSamAccountName: fmt.Sprintf("user%d", i),
+			Mail:                     fmt.Sprintf("user%d@example.org", i),
+			PasswordProfile:          &proto.PasswordProfile{Password: "$2a$11$4WNffzgU/WrIRiDnwu8OnOwgOIIUqR/2Ptvp7WJAQCTSgSrylyuvC"},
+			MemberOf: []*proto.Group{
+				{Id: fmt.Sprintf("group-%d", i%_benchmarkGroups)},
+				{Id: fmt.Sprintf("group-%d", (i+1)%_benchmarkGroups)},
+			},
+		}
+		if err = svc.writeAccount(&a); err != nil {
+			tb.Fatal(err)
+		}
+		// index in batches, indexing every account on its own takes too long
+		ba := &proto.BleveAccount{Account: a, BleveType: "account"}
+		if storeRecords {
+			if ba.Source, err = accountSource(&a); err != nil {
+				tb.Fatal(err)
+			}
+		}
+		if err = batch.Index(ba.Id, ba); err != nil {
+			tb.Fatal(err)
+		}
+		if batch.Size() >= 1000 {
+			if err = svc.index.Batch(batch); err != nil {
+				tb.Fatal(err)
+			}
+			batch = svc.index.NewBatch()
+		}
+	}
+	if err = svc.index.Batch(batch); err != nil {
+		tb.Fatal(err)
+	}
+	return svc, teardown
+}
+
+func TestListAccountsFromStoredRecords(t *testing.T) {
+	files, teardownFiles := newBenchmarkService(t, false, 50)
+	defer teardownFiles()
+	stored, teardownStored := newBenchmarkService(t, true, 50)
+	defer teardownStored()
+
+	for _, mask := range []*field_mask.FieldMask{nil, {Paths: []string{"Id", "MemberOf.DisplayName"}}} {
+		fromFiles := &proto.ListAccountsResponse{}
+		assert.NoError(t, files.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: 20, FieldMask: mask}, fromFiles))
+		fromIndex := &proto.ListAccountsResponse{}
+		assert.NoError(t, stored.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: 20, FieldMask: mask}, fromIndex))
+
+		if assert.Len(t, fromIndex.Accounts, 20) {
+			for i := range fromFiles.Accounts {
+				assert.Equal(t, fromFiles.Accounts[i].String(), fromIndex.Accounts[i].String())
+			}
+		}
+	}
+}
+
+func benchmarkListAccounts(b *testing.B, storeRecords bool) {
+	svc, teardown := newBenchmarkService(b, storeRecords, _benchmarkAccounts)
+	defer teardown()
+
+	for _, bm := range []struct {
+		name string
+		mask *field_mask.FieldMask
+	}{
+		{"page of 100", &field_mask.FieldMask{Paths: []string{"Id", "DisplayName", "Mail"}}},
+		{"page of 100 with groups", nil},
+	} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				out := &proto.ListAccountsResponse{}
+				if err := svc.ListAccounts(context.Background(), &proto.ListAccountsRequest{PageSize: 100, FieldMask: bm.mask}, out); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkListAccountsFromFiles(b *testing.B) {
+	benchmarkListAccounts(b, false)
+}
+
+func BenchmarkListAccountsFromStoredRecords(b *testing.B) {
+	benchmarkListAccounts(b, true)
+}
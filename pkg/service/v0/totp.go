@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	merrors "github.com/micro/go-micro/v2/errors"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/mfa"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// defaultMFAIssuer is used when the config does not set the issuer
+const defaultMFAIssuer = "ownCloud"
+
+// newSecretsCipher returns the cipher for the TOTP secrets, or nil if neither a key nor a key file is configured. The
+// key file must not be in the accounts data path, whoever can read the encrypted secrets must not be able to read the
+// key as well.
+func newSecretsCipher(cfg *config.Config) (*mfa.Cipher, error) {
+	key := []byte(cfg.MFA.SecretKey)
+	if len(key) == 0 && cfg.MFA.SecretKeyFile != "" {
+		inside, err := pathInside(cfg.MFA.SecretKeyFile, cfg.Server.AccountsDataPath)
+		if err != nil {
+			return nil, err
+		}
+		if inside {
+			return nil, fmt.Errorf("the MFA secret key file %s must not be in the accounts data path", cfg.MFA.SecretKeyFile)
+		}
+		if key, err = mfa.LoadOrCreateKey(cfg.MFA.SecretKeyFile); err != nil {
+			return nil, err
+		}
+	}
+	if len(key) == 0 {
+		return nil, nil
+	}
+	return mfa.NewCipher(key)
+}
+
+// pathInside returns true if path is dir or inside of dir
+func pathInside(path, dir string) (bool, error) {
+	path, err := filepath.Abs(path)
+	if err != nil {
+		return false, err
+	}
+	if dir, err = filepath.Abs(dir); err != nil {
+		return false, err
+	}
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false, nil
+	}
+	return rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)), nil
+}
+
+func (s Service) mfaIssuer() string {
+	if s.Config.MFA.Issuer != "" {
+		return s.Config.MFA.Issuer
+	}
+	return defaultMFAIssuer
+}
+
+// totpEnabled returns true if the account confirmed a TOTP secret, sign-ins require a code then
+func totpEnabled(a *proto.Account) bool {
+	return a.GetMultiFactorProfile().GetTotpEnabled()
+}
+
+// totpSecret decrypts the TOTP secret of the account
+func (s Service) totpSecret(a *proto.Account) ([]byte, error) {
+	if s.secrets == nil {
+		return nil, merrors.InternalServerError(s.id, "no key for the TOTP secrets configured")
+	}
+	return s.secrets.Decrypt(a.GetMultiFactorProfile().GetEncryptedTotpSecret(), []byte(a.Id))
+}
+
+// verifyTotpCode checks a TOTP code of the account. Codes of a time step that was already used are rejected, so the
+// account has to be saved when the code was accepted.
+func (s Service) verifyTotpCode(a *proto.Account, code string, now time.Time) bool {
+	secret, err := s.totpSecret(a)
+	if err != nil {
+		s.log.Error().Err(err).Str("id", a.Id).Msg("could not decrypt TOTP secret")
+		return false
+	}
+	step, ok := mfa.Validate(secret, code, now)
+	if !ok || step <= a.MultiFactorProfile.LastTotpStep {
+		return false
+	}
+	a.MultiFactorProfile.LastTotpStep = step
+	return true
+}
+
+// verifySecondFactor checks a TOTP code or a recovery code of the account. Recovery codes can only be used once, so
+// the account has to be saved when the code was accepted.
+func (s Service) verifySecondFactor(a *proto.Account, code string, now time.Time) bool {
+	if code == "" || a.MultiFactorProfile == nil {
+		return false
+	}
+	if s.verifyTotpCode(a, code, now) {
+		return true
+	}
+	mp := a.MultiFactorProfile
+	i := mfa.MatchRecoveryCode(mp.RecoveryCodeHashes, code)
+	if i < 0 {
+		return false
+	}
+	mp.RecoveryCodeHashes = append(mp.RecoveryCodeHashes[:i], mp.RecoveryCodeHashes[i+1:]...)
+	mp.RemainingRecoveryCodes = int32(len(mp.RecoveryCodeHashes))
+	s.log.Info().Str("id", a.Id).Int32("remaining", mp.RemainingRecoveryCodes).Msg("used recovery code")
+	return true
+}
+
+// totpLabel returns the name of the account shown in authenticator apps
+func totpLabel(a *proto.Account) string {
+	for _, v := range []string{a.PreferredName, a.OnPremisesSamAccountName, a.Mail} {
+		if v != "" {
+			return v
+		}
+	}
+	return a.Id
+}
+
+// EnrollTotp implements the AccountsServiceHandler interface
+func (s Service) EnrollTotp(ctx context.Context, in *proto.EnrollTotpRequest, out *proto.EnrollTotpResponse) (err error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "EnrollTotp", a); err != nil {
+		return
+	}
+	if totpEnabled(a) {
+		return merrors.Conflict(s.id, "TOTP is already enabled, remove it first")
+	}
+	if s.secrets == nil {
+		return merrors.InternalServerError(s.id, "no key for the TOTP secrets configured")
+	}
+	// admins can enroll TOTP for others, but users have to prove they are not using a stolen session
+	if s.isOwnAccount(ctx, a.Id) {
+		if err = s.reauthenticate(ctx, a, in.Password, ""); err != nil {
+			return
+		}
+	}
+
+	secret, err := mfa.GenerateSecret()
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not generate TOTP secret: %v", err.Error())
+	}
+	encrypted, err := s.secrets.Encrypt(secret, []byte(a.Id))
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not encrypt TOTP secret: %v", err.Error())
+	}
+
+	// the secret is only used after it was confirmed with VerifyTotp
+	a.MultiFactorProfile = &proto.MultiFactorProfile{EncryptedTotpSecret: encrypted}
+	if err = s.persistAccount(a); err != nil {
+		return
+	}
+	s.log.Info().Str("id", a.Id).Msg("enrolled TOTP secret")
+
+	out.Secret = mfa.EncodeSecret(secret)
+	out.KeyUri = mfa.KeyURI(s.mfaIssuer(), totpLabel(a), secret)
+	return nil
+}
+
+// VerifyTotp implements the AccountsServiceHandler interface
+func (s Service) VerifyTotp(ctx context.Context, in *proto.VerifyTotpRequest, out *proto.VerifyTotpResponse) (err error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	a := &proto.Account{}
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "VerifyTotp", a); err != nil {
+		return
+	}
+	if a.GetMultiFactorProfile().GetEncryptedTotpSecret() == "" {
+		return merrors.BadRequest(s.id, "no TOTP secret enrolled")
+	}
+	if totpEnabled(a) {
+		return merrors.Conflict(s.id, "TOTP is already enabled")
+	}
+
+	// codes are guessed like passwords, so failed codes count as failed sign-ins
+	now := time.Now()
+	source := s.clientAddress(ctx)
+	if s.sourceLocked(source, now) {
+		return merrors.Forbidden(s.id, "too many failed attempts, try again later")
+	}
+	if accountLocked(a, now) {
+		return merrors.Forbidden(s.id, "account is locked")
+	}
+	if !s.verifyTotpCode(a, in.Code, now) {
+		s.recordFailedSource(source, now)
+		s.recordFailedSignIn(a, now)
+		s.saveAccount(a)
+		return merrors.BadRequest(s.id, "invalid TOTP code")
+	}
+
+	codes, err := mfa.GenerateRecoveryCodes(mfa.RecoveryCodes)
+	if err != nil {
+		return merrors.InternalServerError(s.id, "could not generate recovery codes: %v", err.Error())
+	}
+	mp := a.MultiFactorProfile
+	mp.RecoveryCodeHashes = make([]string, 0, len(codes))
+	for _, c := range codes {
+		mp.RecoveryCodeHashes = append(mp.RecoveryCodeHashes, mfa.HashRecoveryCode(c))
+	}
+	mp.RemainingRecoveryCodes = int32(len(codes))
+	mp.TotpEnabled = true
+	mp.TotpEnabledDateTime = timestamppb.New(now)
+
+	if err = s.persistAccount(a); err != nil {
+		return
+	}
+	s.log.Info().Str("id", a.Id).Msg("enabled TOTP")
+
+	out.RecoveryCodes = codes
+	return nil
+}
+
+// RemoveTotp implements the AccountsServiceHandler interface
+func (s Service) RemoveTotp(ctx context.Context, in *proto.RemoveTotpRequest, out *proto.Account) (err error) {
+	accLock.Lock()
+	defer accLock.Unlock()
+
+	if _, err = s.loadManagedAccount(ctx, in.AccountId, "RemoveTotp", out); err != nil {
+		return
+	}
+
+	// users have to prove they know the password and still have the second factor, admins can remove it for lost
+	// devices of others
+	if s.isOwnAccount(ctx, out.Id) {
+		if err = s.reauthenticate(ctx, out, in.Password, in.Code); err != nil {
+			return
+		}
+	}
+
+	if out.MultiFactorProfile != nil {
+		out.MultiFactorProfile = nil
+		if err = s.persistAccount(out); err != nil {
+			return
+		}
+		s.log.Info().Str("id", out.Id).Msg("removed TOTP")
+	}
+
+	// remove passwords
+	removeSecrets(out)
+	return nil
+}
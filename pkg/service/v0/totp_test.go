@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/micro/go-micro/v2/metadata"
+	"github.com/owncloud/ocis-accounts/pkg/config"
+	"github.com/owncloud/ocis-accounts/pkg/mfa"
+	"github.com/owncloud/ocis-accounts/pkg/proto/v0"
+	"github.com/owncloud/ocis-pkg/v2/middleware"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTotp(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	var err error
+	svc.secrets, err = mfa.NewCipher([]byte("test key"))
+	assert.NoError(t, err)
+
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	ctx := context.Background()
+	authenticate := func(code string) proto.AuthenticationFailure {
+		out := &proto.AuthenticateAccountResponse{}
+		assert.NoError(t, svc.AuthenticateAccount(ctx, &proto.AuthenticateAccountRequest{Login: "einstein", Password: "relativity", MfaCode: code}, out))
+		return out.Failure
+	}
+
+	enrollment := &proto.EnrollTotpResponse{}
+	assert.NoError(t, svc.EnrollTotp(ctx, &proto.EnrollTotpRequest{AccountId: "einstein"}, enrollment))
+	assert.Contains(t, enrollment.KeyUri, "otpauth://totp/ownCloud:einstein?")
+	secret, err := mfa.DecodeSecret(enrollment.Secret)
+	assert.NoError(t, err)
+
+	raw, err := ioutil.ReadFile(filepath.Join(svc.Config.Server.AccountsDataPath, "accounts", "einstein"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(raw), enrollment.Secret, "the secret is encrypted at rest")
+
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(""), "unconfirmed secrets are not required")
+
+	now := time.Now()
+	assert.Error(t, svc.VerifyTotp(ctx, &proto.VerifyTotpRequest{AccountId: "einstein", Code: "000000"}, &proto.VerifyTotpResponse{}))
+	verification := &proto.VerifyTotpResponse{}
+	assert.NoError(t, svc.VerifyTotp(ctx, &proto.VerifyTotpRequest{AccountId: "einstein", Code: mfa.Code(secret, mfa.Step(now))}, verification))
+	assert.Len(t, verification.RecoveryCodes, mfa.RecoveryCodes)
+
+	assert.Error(t, svc.EnrollTotp(ctx, &proto.EnrollTotpRequest{AccountId: "einstein"}, &proto.EnrollTotpResponse{}), "enabled secrets have to be removed first")
+
+	assert.Equal(t, proto.AuthenticationFailure_MFA_REQUIRED, authenticate(""))
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_MFA_CODE, authenticate(mfa.Code(secret, mfa.Step(now))), "codes cannot be replayed")
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(mfa.Code(secret, mfa.Step(now)+1)))
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(verification.RecoveryCodes[0]))
+	assert.Equal(t, proto.AuthenticationFailure_INVALID_MFA_CODE, authenticate(verification.RecoveryCodes[0]), "recovery codes can only be used once")
+
+	got := &proto.Account{}
+	assert.NoError(t, svc.GetAccount(ctx, &proto.GetAccountRequest{Id: "einstein"}, got))
+	if assert.NotNil(t, got.MultiFactorProfile) {
+		assert.True(t, got.MultiFactorProfile.TotpEnabled)
+		assert.NotNil(t, got.MultiFactorProfile.TotpEnabledDateTime)
+		assert.Equal(t, int32(mfa.RecoveryCodes-1), got.MultiFactorProfile.RemainingRecoveryCodes)
+		assert.Empty(t, got.MultiFactorProfile.EncryptedTotpSecret)
+		assert.Empty(t, got.MultiFactorProfile.RecoveryCodeHashes)
+	}
+
+	removed := &proto.Account{}
+	assert.NoError(t, svc.RemoveTotp(ctx, &proto.RemoveTotpRequest{AccountId: "einstein"}, removed))
+	assert.Nil(t, removed.MultiFactorProfile)
+	assert.Equal(t, proto.AuthenticationFailure_NO_FAILURE, authenticate(""))
+}
+
+func TestTotpOwnAccount(t *testing.T) {
+	svc, teardown := newTestService(t)
+	defer teardown()
+	svc.Config.Auth.LockoutThreshold = 3
+	var err error
+	svc.secrets, err = mfa.NewCipher([]byte("test key"))
+	assert.NoError(t, err)
+
+	addTestAccount(t, svc, einstein(), "relativity")
+
+	ctx := metadata.Set(context.Background(), middleware.AccountID, "einstein")
+	failedAttempts := func() int32 {
+		got := &proto.Account{}
+		assert.NoError(t, svc.loadAccount("einstein", got))
+		return got.FailedSignInAttempts
+	}
+
+	assert.Error(t, svc.EnrollTotp(ctx, &proto.EnrollTotpRequest{AccountId: "einstein"}, &proto.EnrollTotpResponse{}), "a stolen session is not enough")
+	assert.Error(t, svc.EnrollTotp(ctx, &proto.EnrollTotpRequest{AccountId: "einstein", Password: "quantum"}, &proto.EnrollTotpResponse{}))
+	assert.Equal(t, int32(1), failedAttempts(), "wrong passwords count as failed sign-ins")
+
+	enrollment := &proto.EnrollTotpResponse{}
+	assert.NoError(t, svc.EnrollTotp(ctx, &proto.EnrollTotpRequest{AccountId: "einstein", Password: "relativity"}, enrollment))
+	assert.Equal(t, int32(0), failedAttempts())
+	secret, err := mfa.DecodeSecret(enrollment.Secret)
+	assert.NoError(t, err)
+
+	now := time.Now()
+	assert.Error(t, svc.VerifyTotp(ctx, &proto.VerifyTotpRequest{AccountId: "einstein", Code: "000000"}, &proto.VerifyTotpResponse{}))
+	assert.Equal(t, int32(1), failedAttempts(), "wrong codes count as failed sign-ins")
+	assert.NoError(t, svc.VerifyTotp(ctx, &proto.VerifyTotpRequest{AccountId: "einstein", Code: mfa.Code(secret, mfa.Step(now))}, &proto.VerifyTotpResponse{}))
+
+	removeTotp := func(password, code string) error {
+		return svc.RemoveTotp(ctx, &proto.RemoveTotpRequest{AccountId: "einstein", Password: password, Code: code}, &proto.Account{})
+	}
+	assert.Error(t, removeTotp("", mfa.Code(secret, mfa.Step(now)+1)), "the password is required")
+	assert.Error(t, removeTotp("relativity", ""), "the second factor is required")
+	assert.Error(t, removeTotp("relativity", "000000"))
+	assert.Error(t, removeTotp("relativity", "000001"))
+	assert.Error(t, removeTotp("relativity", mfa.Code(secret, mfa.Step(now)+1)), "the account is locked after guessing codes")
+	assert.Equal(t, int32(3), failedAttempts())
+
+	// admins can remove TOTP for lost devices without the credentials of the user
+	assert.NoError(t, svc.RemoveTotp(context.Background(), &proto.RemoveTotpRequest{AccountId: "einstein"}, &proto.Account{}))
+}
+
+func TestNewSecretsCipher(t *testing.T) {
+	dir, err := ioutil.TempDir("", "ocis-accounts-mfa")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	cfg := config.New()
+	cfg.Server.AccountsDataPath = filepath.Join(dir, "data")
+	c, err := newSecretsCipher(cfg)
+	assert.NoError(t, err)
+	assert.Nil(t, c, "no key is generated in the data path")
+
+	cfg.MFA.SecretKeyFile = filepath.Join(cfg.Server.AccountsDataPath, "mfa.key")
+	_, err = newSecretsCipher(cfg)
+	assert.Error(t, err, "the key must not be stored with the secrets")
+
+	cfg.MFA.SecretKeyFile = filepath.Join(dir, "keys", "mfa.key")
+	c, err = newSecretsCipher(cfg)
+	assert.NoError(t, err)
+	encrypted, err := c.Encrypt([]byte("secret"), []byte("einstein"))
+	assert.NoError(t, err)
+	c, err = newSecretsCipher(cfg)
+	assert.NoError(t, err)
+	decrypted, err := c.Decrypt(encrypted, []byte("einstein"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("secret"), decrypted, "the generated key is reused")
+}